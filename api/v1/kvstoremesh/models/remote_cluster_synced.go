@@ -11,8 +11,10 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // RemoteClusterSynced Status of the synchronization with the remote cluster, about each resource
@@ -28,18 +30,103 @@ type RemoteClusterSynced struct {
 	// Endpoints synchronization status
 	Endpoints bool `json:"endpoints,omitempty"`
 
+	// Time of the last completed initial synchronization of endpoints
+	// Format: date-time
+	EndpointsLastSync strfmt.DateTime `json:"endpoints-last-sync,omitempty"`
+
 	// Identities synchronization status
 	Identities bool `json:"identities,omitempty"`
 
+	// Time of the last completed initial synchronization of identities
+	// Format: date-time
+	IdentitiesLastSync strfmt.DateTime `json:"identities-last-sync,omitempty"`
+
 	// Nodes synchronization status
 	Nodes bool `json:"nodes,omitempty"`
 
+	// Time of the last completed initial synchronization of nodes
+	// Format: date-time
+	NodesLastSync strfmt.DateTime `json:"nodes-last-sync,omitempty"`
+
 	// Services synchronization status
 	Services bool `json:"services,omitempty"`
+
+	// Time of the last completed initial synchronization of services
+	// Format: date-time
+	ServicesLastSync strfmt.DateTime `json:"services-last-sync,omitempty"`
 }
 
 // Validate validates this remote cluster synced
 func (m *RemoteClusterSynced) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEndpointsLastSync(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIdentitiesLastSync(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNodesLastSync(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateServicesLastSync(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RemoteClusterSynced) validateEndpointsLastSync(formats strfmt.Registry) error {
+	if swag.IsZero(m.EndpointsLastSync) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("endpoints-last-sync", "body", "date-time", m.EndpointsLastSync.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RemoteClusterSynced) validateIdentitiesLastSync(formats strfmt.Registry) error {
+	if swag.IsZero(m.IdentitiesLastSync) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("identities-last-sync", "body", "date-time", m.IdentitiesLastSync.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RemoteClusterSynced) validateNodesLastSync(formats strfmt.Registry) error {
+	if swag.IsZero(m.NodesLastSync) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("nodes-last-sync", "body", "date-time", m.NodesLastSync.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RemoteClusterSynced) validateServicesLastSync(formats strfmt.Registry) error {
+	if swag.IsZero(m.ServicesLastSync) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("services-last-sync", "body", "date-time", m.ServicesLastSync.String(), formats); err != nil {
+		return err
+	}
+
 	return nil
 }
 
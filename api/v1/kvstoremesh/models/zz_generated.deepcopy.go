@@ -27,6 +27,10 @@ func (in *RemoteClusterConfig) DeepCopy() *RemoteClusterConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteClusterSynced) DeepCopyInto(out *RemoteClusterSynced) {
 	*out = *in
+	in.EndpointsLastSync.DeepCopyInto(&out.EndpointsLastSync)
+	in.IdentitiesLastSync.DeepCopyInto(&out.IdentitiesLastSync)
+	in.NodesLastSync.DeepCopyInto(&out.NodesLastSync)
+	in.ServicesLastSync.DeepCopyInto(&out.ServicesLastSync)
 	return
 }
 
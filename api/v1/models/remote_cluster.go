@@ -10,6 +10,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -30,6 +31,9 @@ type RemoteCluster struct {
 	// Indicates whether the connection to the remote kvstore is established
 	Connected bool `json:"connected,omitempty"`
 
+	// History of the most recent connection events, from oldest to newest
+	ConnectionEvents []*RemoteClusterConnectionEvent `json:"connection-events"`
+
 	// Time of last failure that occurred while attempting to reach the cluster
 	// Format: date-time
 	LastFailure strfmt.DateTime `json:"last-failure,omitempty"`
@@ -70,6 +74,10 @@ func (m *RemoteCluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateConnectionEvents(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateLastFailure(formats); err != nil {
 		res = append(res, err)
 	}
@@ -103,6 +111,32 @@ func (m *RemoteCluster) validateConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *RemoteCluster) validateConnectionEvents(formats strfmt.Registry) error {
+	if swag.IsZero(m.ConnectionEvents) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ConnectionEvents); i++ {
+		if swag.IsZero(m.ConnectionEvents[i]) { // not required
+			continue
+		}
+
+		if m.ConnectionEvents[i] != nil {
+			if err := m.ConnectionEvents[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("connection-events" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("connection-events" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *RemoteCluster) validateLastFailure(formats strfmt.Registry) error {
 	if swag.IsZero(m.LastFailure) { // not required
 		return nil
@@ -142,6 +176,10 @@ func (m *RemoteCluster) ContextValidate(ctx context.Context, formats strfmt.Regi
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateConnectionEvents(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateSynced(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -173,6 +211,31 @@ func (m *RemoteCluster) contextValidateConfig(ctx context.Context, formats strfm
 	return nil
 }
 
+func (m *RemoteCluster) contextValidateConnectionEvents(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ConnectionEvents); i++ {
+
+		if m.ConnectionEvents[i] != nil {
+
+			if swag.IsZero(m.ConnectionEvents[i]) { // not required
+				return nil
+			}
+
+			if err := m.ConnectionEvents[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("connection-events" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("connection-events" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *RemoteCluster) contextValidateSynced(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Synced != nil {
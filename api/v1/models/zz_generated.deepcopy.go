@@ -1154,11 +1154,22 @@ func (in *RemoteCluster) DeepCopyInto(out *RemoteCluster) {
 		*out = new(RemoteClusterConfig)
 		**out = **in
 	}
+	if in.ConnectionEvents != nil {
+		in, out := &in.ConnectionEvents, &out.ConnectionEvents
+		*out = make([]*RemoteClusterConnectionEvent, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RemoteClusterConnectionEvent)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	in.LastFailure.DeepCopyInto(&out.LastFailure)
 	if in.Synced != nil {
 		in, out := &in.Synced, &out.Synced
 		*out = new(RemoteClusterSynced)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -1189,9 +1200,30 @@ func (in *RemoteClusterConfig) DeepCopy() *RemoteClusterConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteClusterConnectionEvent) DeepCopyInto(out *RemoteClusterConnectionEvent) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteClusterConnectionEvent.
+func (in *RemoteClusterConnectionEvent) DeepCopy() *RemoteClusterConnectionEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteClusterConnectionEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteClusterSynced) DeepCopyInto(out *RemoteClusterSynced) {
 	*out = *in
+	in.EndpointsLastSync.DeepCopyInto(&out.EndpointsLastSync)
+	in.IdentitiesLastSync.DeepCopyInto(&out.IdentitiesLastSync)
+	in.NodesLastSync.DeepCopyInto(&out.NodesLastSync)
+	in.ServicesLastSync.DeepCopyInto(&out.ServicesLastSync)
 	return
 }
 
@@ -341,17 +341,37 @@ func init() {
           "description": "Endpoints synchronization status",
           "type": "boolean"
         },
+        "endpoints-last-sync": {
+          "description": "Time of the last completed initial synchronization of endpoints",
+          "type": "string",
+          "format": "date-time"
+        },
         "identities": {
           "description": "Identities synchronization status",
           "type": "boolean"
         },
+        "identities-last-sync": {
+          "description": "Time of the last completed initial synchronization of identities",
+          "type": "string",
+          "format": "date-time"
+        },
         "nodes": {
           "description": "Nodes synchronization status",
           "type": "boolean"
         },
+        "nodes-last-sync": {
+          "description": "Time of the last completed initial synchronization of nodes",
+          "type": "string",
+          "format": "date-time"
+        },
         "services": {
           "description": "Services synchronization status",
           "type": "boolean"
+        },
+        "services-last-sync": {
+          "description": "Time of the last completed initial synchronization of services",
+          "type": "string",
+          "format": "date-time"
         }
       }
     }
@@ -4640,6 +4640,13 @@ func init() {
           "description": "Indicates whether the connection to the remote kvstore is established",
           "type": "boolean"
         },
+        "connection-events": {
+          "description": "History of the most recent connection events, from oldest to newest",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RemoteClusterConnectionEvent"
+          }
+        },
         "last-failure": {
           "description": "Time of last failure that occurred while attempting to reach the cluster",
           "type": "string",
@@ -4708,6 +4715,29 @@ func init() {
         }
       }
     },
+    "RemoteClusterConnectionEvent": {
+      "description": "Event concerning the connection to a given remote cluster\n\n+k8s:deepcopy-gen=true",
+      "properties": {
+        "reason": {
+          "description": "Human readable description of the cause of the event",
+          "type": "string"
+        },
+        "timestamp": {
+          "description": "Time at which the event occurred",
+          "type": "string",
+          "format": "date-time"
+        },
+        "type": {
+          "description": "Type of the connection event",
+          "type": "string",
+          "enum": [
+            "connected",
+            "disconnected",
+            "failure"
+          ]
+        }
+      }
+    },
     "RemoteClusterSynced": {
       "description": "Status of the synchronization with the remote cluster, about each resource\ntype. A given resource is considered to be synchronized if the initial\nlist of entries has been completely received from the remote cluster, and\nnew events are currently being watched.\n\n+k8s:deepcopy-gen=true",
       "properties": {
@@ -4715,17 +4745,37 @@ func init() {
           "description": "Endpoints synchronization status",
           "type": "boolean"
         },
+        "endpoints-last-sync": {
+          "description": "Time of the last completed initial synchronization of endpoints",
+          "type": "string",
+          "format": "date-time"
+        },
         "identities": {
           "description": "Identities synchronization status",
           "type": "boolean"
         },
+        "identities-last-sync": {
+          "description": "Time of the last completed initial synchronization of identities",
+          "type": "string",
+          "format": "date-time"
+        },
         "nodes": {
           "description": "Nodes synchronization status",
           "type": "boolean"
         },
+        "nodes-last-sync": {
+          "description": "Time of the last completed initial synchronization of nodes",
+          "type": "string",
+          "format": "date-time"
+        },
         "services": {
           "description": "Services synchronization status",
           "type": "boolean"
+        },
+        "services-last-sync": {
+          "description": "Time of the last completed initial synchronization of services",
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
@@ -10780,6 +10830,13 @@ func init() {
           "description": "Indicates whether the connection to the remote kvstore is established",
           "type": "boolean"
         },
+        "connection-events": {
+          "description": "History of the most recent connection events, from oldest to newest",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RemoteClusterConnectionEvent"
+          }
+        },
         "last-failure": {
           "description": "Time of last failure that occurred while attempting to reach the cluster",
           "type": "string",
@@ -10848,6 +10905,29 @@ func init() {
         }
       }
     },
+    "RemoteClusterConnectionEvent": {
+      "description": "Event concerning the connection to a given remote cluster\n\n+k8s:deepcopy-gen=true",
+      "properties": {
+        "reason": {
+          "description": "Human readable description of the cause of the event",
+          "type": "string"
+        },
+        "timestamp": {
+          "description": "Time at which the event occurred",
+          "type": "string",
+          "format": "date-time"
+        },
+        "type": {
+          "description": "Type of the connection event",
+          "type": "string",
+          "enum": [
+            "connected",
+            "disconnected",
+            "failure"
+          ]
+        }
+      }
+    },
     "RemoteClusterSynced": {
       "description": "Status of the synchronization with the remote cluster, about each resource\ntype. A given resource is considered to be synchronized if the initial\nlist of entries has been completely received from the remote cluster, and\nnew events are currently being watched.\n\n+k8s:deepcopy-gen=true",
       "properties": {
@@ -10855,17 +10935,37 @@ func init() {
           "description": "Endpoints synchronization status",
           "type": "boolean"
         },
+        "endpoints-last-sync": {
+          "description": "Time of the last completed initial synchronization of endpoints",
+          "type": "string",
+          "format": "date-time"
+        },
         "identities": {
           "description": "Identities synchronization status",
           "type": "boolean"
         },
+        "identities-last-sync": {
+          "description": "Time of the last completed initial synchronization of identities",
+          "type": "string",
+          "format": "date-time"
+        },
         "nodes": {
           "description": "Nodes synchronization status",
           "type": "boolean"
         },
+        "nodes-last-sync": {
+          "description": "Time of the last completed initial synchronization of nodes",
+          "type": "string",
+          "format": "date-time"
+        },
         "services": {
           "description": "Services synchronization status",
           "type": "boolean"
+        },
+        "services-last-sync": {
+          "description": "Time of the last completed initial synchronization of services",
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
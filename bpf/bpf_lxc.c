@@ -25,6 +25,7 @@
 #include "lib/qm.h"
 #include "lib/ipv6.h"
 #include "lib/ipv4.h"
+#include "lib/dscp.h"
 #include "lib/icmp6.h"
 #include "lib/eth.h"
 #include "lib/dbg.h"
@@ -411,6 +412,10 @@ static __always_inline int handle_ipv6_from_lxc(struct __ctx_buff *ctx, __u32 *d
 	if (!revalidate_data(ctx, &data, &data_end, &ip6))
 		return DROP_INVALID;
 
+#ifdef LXC_DSCP_EGRESS
+	ipv6_set_dscp(ip6, LXC_DSCP_EGRESS);
+#endif
+
 	/* Determine the destination category for policy fallback.  Service
 	 * translation of the destination address is done before this function,
 	 * so we can do this first.
@@ -847,6 +852,16 @@ static __always_inline int handle_ipv4_from_lxc(struct __ctx_buff *ctx, __u32 *d
 	if (!revalidate_data(ctx, &data, &data_end, &ip4))
 		return DROP_INVALID;
 
+#ifdef LXC_DSCP_EGRESS
+	ret = ipv4_set_dscp(ctx, ETH_HLEN, ip4, LXC_DSCP_EGRESS);
+	if (ret < 0)
+		return ret;
+
+	/* The checksum fixup may have invalidated the packet pointers. */
+	if (!revalidate_data(ctx, &data, &data_end, &ip4))
+		return DROP_INVALID;
+#endif /* LXC_DSCP_EGRESS */
+
 	has_l4_header = ipv4_has_l4_header(ip4);
 
 #ifdef ENABLE_PER_PACKET_LB
@@ -1005,7 +1005,11 @@ struct lb6_service {
 	__u16 rev_nat_index;
 	__u8 flags;
 	__u8 flags2;
-	__u8 pad[2];
+	/* Shared source range set ID in network byte order, zero when the
+	 * source ranges are keyed by rev_nat_index. Only set in the service
+	 * frontend.
+	 */
+	__u16 src_range_set_id;
 };
 
 /* See lb4_backend comments */
@@ -1029,6 +1033,8 @@ struct lb6_health {
 struct lb6_reverse_nat {
 	union v6addr address;
 	__be16 port;
+	__u8 proto;		/* L4 protocol of the frontend, zero for any */
+	__u8 pad;
 } __packed;
 
 struct ipv6_revnat_tuple {
@@ -1066,7 +1072,11 @@ struct lb4_service {
 	__u16 rev_nat_index;	/* Reverse NAT ID in lb4_reverse_nat */
 	__u8 flags;
 	__u8 flags2;
-	__u8  pad[2];
+	/* Shared source range set ID in network byte order, zero when the
+	 * source ranges are keyed by rev_nat_index. Only set in the service
+	 * frontend.
+	 */
+	__u16 src_range_set_id;
 };
 
 struct lb4_backend {
@@ -1089,6 +1099,8 @@ struct lb4_health {
 struct lb4_reverse_nat {
 	__be32 address;
 	__be16 port;
+	__u8 proto;		/* L4 protocol of the frontend, zero for any */
+	__u8 pad;
 } __packed;
 
 struct ipv4_revnat_tuple {
@@ -0,0 +1,57 @@
+/* SPDX-License-Identifier: (GPL-2.0-only OR BSD-2-Clause) */
+/* Copyright Authors of Cilium */
+
+#ifndef __LIB_DSCP__
+#define __LIB_DSCP__
+
+#include <linux/ip.h>
+#include <linux/ipv6.h>
+
+#include "common.h"
+#include "ipv4.h"
+
+/* ipv4_set_dscp rewrites the DSCP bits of the ToS field while preserving the
+ * ECN bits, and fixes up the IPv4 header checksum accordingly. Callers must
+ * revalidate the packet pointers afterwards, as the checksum update may
+ * invalidate them.
+ */
+static __always_inline int ipv4_set_dscp(struct __ctx_buff *ctx, int l3_off,
+					 struct iphdr *ip4, __u8 dscp)
+{
+	__u8 tos = (__u8)(dscp << 2) | (ip4->tos & 0x3);
+	union {
+		__u8 b[2];
+		__be16 word;
+	} w_old, w_new;
+
+	if (ip4->tos == tos)
+		return 0;
+
+	/* The ToS shares its 16-bit checksum word with the version/ihl byte. */
+	w_old.b[0] = *(__u8 *)ip4;
+	w_old.b[1] = ip4->tos;
+	w_new.b[0] = w_old.b[0];
+	w_new.b[1] = tos;
+
+	ip4->tos = tos;
+
+	if (ipv4_csum_update_by_value(ctx, l3_off, w_old.word, w_new.word, 2) < 0)
+		return DROP_CSUM_L3;
+
+	return 0;
+}
+
+/* ipv6_set_dscp rewrites the DSCP bits of the traffic class field while
+ * preserving the ECN bits. IPv6 has no header checksum, so the packet
+ * pointers remain valid.
+ */
+static __always_inline void ipv6_set_dscp(struct ipv6hdr *ip6, __u8 dscp)
+{
+	/* The traffic class spans the lower nibble of the first byte and the
+	 * upper nibble of the second one.
+	 */
+	ip6->priority = dscp >> 2;
+	ip6->flow_lbl[0] = (__u8)((dscp & 0x3) << 6) | (ip6->flow_lbl[0] & 0x3f);
+}
+
+#endif /* __LIB_DSCP__ */
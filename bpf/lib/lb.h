@@ -582,7 +582,7 @@ bool lb6_src_range_ok(const struct lb6_service *svc __maybe_unused,
 
 	key = (typeof(key)) {
 		.lpm_key = { SRC_RANGE_STATIC_PREFIX(key), {} },
-		.rev_nat_id = svc->rev_nat_index,
+		.rev_nat_id = svc->src_range_set_id ? : svc->rev_nat_index,
 		.addr = *saddr,
 	};
 
@@ -1210,7 +1210,7 @@ bool lb4_src_range_ok(const struct lb4_service *svc __maybe_unused,
 
 	key = (typeof(key)) {
 		.lpm_key = { SRC_RANGE_STATIC_PREFIX(key), {} },
-		.rev_nat_id = svc->rev_nat_index,
+		.rev_nat_id = svc->src_range_set_id ? : svc->rev_nat_index,
 		.addr = saddr,
 	};
 
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/cilium/pkg/common"
+	"github.com/cilium/cilium/pkg/maps/lbmap"
+)
+
+// bpfLBBackupCmd represents the bpf_lb_backup command
+var bpfLBBackupCmd = &cobra.Command{
+	Use:   "backup <file>",
+	Short: "Backup the LB maps into a file",
+	Long: "Snapshot the full LB map set (services, backends, reverse NAT, " +
+		"source ranges and affinity match, with all IDs preserved) into a " +
+		"file that can later be loaded with \"cilium-dbg bpf lb restore\", " +
+		"e.g. on another node or after a map wipe",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		common.RequireRootPrivilege("cilium bpf lb backup")
+
+		// Ensure that the BPF map objects have been initialized before trying
+		// to dump them. Note, this is _not_ creating a new map, but rather
+		// initializing the Go object representing the map. We don't need to
+		// pass the correct sizes here because once the maps are opened, their
+		// size will be read.
+		lbmap.Init(lbmap.InitParams{IPv4: true, IPv6: true})
+
+		snapshot, err := lbmap.DumpSnapshot()
+		if err != nil {
+			Fatalf("Unable to dump LB maps: %s", err)
+		}
+
+		out, err := json.MarshalIndent(snapshot, "", "  ")
+		if err != nil {
+			Fatalf("Unable to generate JSON output: %s", err)
+		}
+		if err := os.WriteFile(args[0], out, 0600); err != nil {
+			Fatalf("Unable to write %s: %s", args[0], err)
+		}
+	},
+}
+
+// bpfLBRestoreCmd represents the bpf_lb_restore command
+var bpfLBRestoreCmd = &cobra.Command{
+	Use:   "restore <file>",
+	Short: "Restore the LB maps from a backup file",
+	Long: "Load a snapshot taken with \"cilium-dbg bpf lb backup\" back into " +
+		"the LB maps, creating maps as needed and preserving all service and " +
+		"backend IDs. Entries not covered by the snapshot are left untouched",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		common.RequireRootPrivilege("cilium bpf lb restore")
+
+		content, err := os.ReadFile(args[0])
+		if err != nil {
+			Fatalf("Unable to read %s: %s", args[0], err)
+		}
+		snapshot := &lbmap.Snapshot{}
+		if err := json.Unmarshal(content, snapshot); err != nil {
+			Fatalf("Unable to parse %s: %s", args[0], err)
+		}
+
+		lbmap.Init(lbmap.InitParams{IPv4: true, IPv6: true})
+
+		if err := lbmap.RestoreSnapshot(snapshot); err != nil {
+			Fatalf("Unable to restore LB maps: %s", err)
+		}
+		fmt.Printf("Restored LB maps from %s\n", args[0])
+	},
+}
+
+func init() {
+	BPFLBCmd.AddCommand(bpfLBBackupCmd)
+	BPFLBCmd.AddCommand(bpfLBRestoreCmd)
+}
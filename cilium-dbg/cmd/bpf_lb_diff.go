@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/cilium/pkg/command"
+	"github.com/cilium/cilium/pkg/common"
+	"github.com/cilium/cilium/pkg/loadbalancer"
+	"github.com/cilium/cilium/pkg/maps/lbmap"
+)
+
+// bpfLBDiffCmd represents the bpf_lb_diff command
+var bpfLBDiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Diff desired load-balancing state against BPF maps",
+	Long:  "Compare the services known to the agent with the contents of the BPF maps, reporting missing, extra and divergent entries",
+	Run: func(cmd *cobra.Command, args []string) {
+		common.RequireRootPrivilege("cilium bpf lb diff")
+
+		desired := desiredFrontendsWithBackends()
+
+		// Ensure that the BPF map objects have been initialized before trying
+		// to dump them. Note, this is _not_ creating a new map, but rather
+		// initializing the Go object representing the map. We don't need to
+		// pass the correct sizes here because once the maps are opened, their
+		// size will be read.
+		lbmap.Init(lbmap.InitParams{IPv4: true, IPv6: true})
+
+		actual, err := lbmap.DumpFrontendsWithBackends()
+		if err != nil {
+			Fatalf("Unable to dump the LB maps: %s", err)
+		}
+
+		diff := lbmap.DiffState(desired, actual)
+
+		if command.OutputOption() {
+			if err := command.PrintOutput(diff); err != nil {
+				Fatalf("Unable to generate %s output: %s", command.OutputOptionString(), err)
+			}
+			return
+		}
+
+		if diff.Empty() {
+			fmt.Println("No discrepancies found")
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "STATE\tFRONTEND\tDESIRED BACKENDS\tACTUAL BACKENDS")
+		printDiffEntries(w, "missing", diff.Missing)
+		printDiffEntries(w, "extra", diff.Extra)
+		printDiffEntries(w, "divergent", diff.Divergent)
+		w.Flush()
+		os.Exit(1)
+	},
+}
+
+func printDiffEntries(w *tabwriter.Writer, state string, entries []lbmap.StateDiffEntry) {
+	for _, entry := range entries {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", state, entry.Frontend,
+			joinOrDash(entry.Desired), joinOrDash(entry.Actual))
+	}
+}
+
+func joinOrDash(backends []string) string {
+	if len(backends) == 0 {
+		return "-"
+	}
+
+	out := backends[0]
+	for _, be := range backends[1:] {
+		out += ", " + be
+	}
+	return out
+}
+
+// desiredFrontendsWithBackends retrieves the services known to the agent,
+// returning the mapping from each frontend address to the sorted list of
+// addresses of the associated backends.
+func desiredFrontendsWithBackends() map[string][]string {
+	list, err := client.GetServices()
+	if err != nil {
+		Fatalf("Cannot get services list: %s", err)
+	}
+
+	desired := make(map[string][]string)
+	for _, svc := range list {
+		if svc.Status == nil || svc.Status.Realized == nil {
+			continue
+		}
+
+		feA, err := loadbalancer.NewL3n4AddrFromModel(svc.Status.Realized.FrontendAddress)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error parsing frontend %+v", svc.Status.Realized.FrontendAddress)
+			continue
+		}
+
+		addr := feA.AddrCluster.Addr().String()
+		port := strconv.Itoa(int(feA.Port))
+		frontend := net.JoinHostPort(addr, port)
+		if _, ok := desired[frontend]; !ok {
+			desired[frontend] = []string{}
+		}
+
+		for _, be := range svc.Status.Realized.BackendAddresses {
+			beA, err := loadbalancer.NewL3n4AddrFromBackendModel(be)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "error parsing backend %+v", be)
+				continue
+			}
+
+			addr := beA.AddrCluster.Addr().String()
+			port := strconv.Itoa(int(beA.Port))
+			desired[frontend] = append(desired[frontend], net.JoinHostPort(addr, port))
+		}
+	}
+
+	for _, bes := range desired {
+		sort.Strings(bes)
+	}
+	return desired
+}
+
+func init() {
+	BPFLBCmd.AddCommand(bpfLBDiffCmd)
+	command.AddOutputOption(bpfLBDiffCmd)
+}
@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/common"
+	"github.com/cilium/cilium/pkg/loadbalancer"
+	"github.com/cilium/cilium/pkg/maps/lbmap"
+)
+
+// lbExport is the JSON document produced by "cilium-dbg bpf lb export",
+// aggregating the content of all LB maps in host byte order.
+type lbExport struct {
+	Services      []exportedService       `json:"services"`
+	Backends      []exportedBackend       `json:"backends"`
+	RevNat        []exportedRevNat        `json:"revNat"`
+	SourceRanges  []exportedSourceRange   `json:"sourceRanges"`
+	AffinityMatch []exportedAffinityMatch `json:"affinityMatch"`
+}
+
+type exportedService struct {
+	Frontend    string `json:"frontend"`
+	BackendSlot int    `json:"backendSlot"`
+	BackendID   uint32 `json:"backendId"`
+	Count       int    `json:"count"`
+	RevNatID    int    `json:"revNatId"`
+	Flags       string `json:"flags"`
+}
+
+type exportedBackend struct {
+	ID      uint32 `json:"id"`
+	Address string `json:"address"`
+	State   string `json:"state"`
+	Zone    uint8  `json:"zone,omitempty"`
+}
+
+type exportedRevNat struct {
+	ID      uint16 `json:"id"`
+	Address string `json:"address"`
+}
+
+type exportedSourceRange struct {
+	CIDR     string `json:"cidr"`
+	RevNatID uint16 `json:"revNatId"`
+}
+
+type exportedAffinityMatch struct {
+	BackendID uint32 `json:"backendId"`
+	RevNatID  uint16 `json:"revNatId"`
+}
+
+func exportServices(export *lbExport) {
+	parseSVCEntry := func(key bpf.MapKey, value bpf.MapValue) {
+		svcKey := key.(lbmap.ServiceKey).ToHost()
+		svcVal := value.(lbmap.ServiceValue).ToHost()
+
+		addr := svcKey.GetAddress().String()
+		port := strconv.Itoa(int(svcKey.GetPort()))
+		export.Services = append(export.Services, exportedService{
+			Frontend:    net.JoinHostPort(addr, port),
+			BackendSlot: svcKey.GetBackendSlot(),
+			BackendID:   uint32(svcVal.GetBackendID()),
+			Count:       svcVal.GetCount(),
+			RevNatID:    svcVal.GetRevNat(),
+			Flags:       loadbalancer.ServiceFlags(svcVal.GetFlags()).String(),
+		})
+	}
+
+	if err := lbmap.Service4MapV2.DumpWithCallbackIfExists(parseSVCEntry); err != nil {
+		Fatalf("Unable to dump IPv4 services table: %s", err)
+	}
+	if err := lbmap.Service6MapV2.DumpWithCallbackIfExists(parseSVCEntry); err != nil {
+		Fatalf("Unable to dump IPv6 services table: %s", err)
+	}
+}
+
+func exportBackends(export *lbExport) {
+	parseBackendEntry := func(key bpf.MapKey, value bpf.MapValue) {
+		id := key.(lbmap.BackendKey).GetID()
+		val := value.(lbmap.BackendValue).ToHost()
+
+		state, err := loadbalancer.GetBackendStateFromFlags(val.GetFlags()).String()
+		if err != nil {
+			state = fmt.Sprintf("unknown (%d)", val.GetFlags())
+		}
+
+		addr := val.GetAddress().String()
+		port := strconv.Itoa(int(val.GetPort()))
+		export.Backends = append(export.Backends, exportedBackend{
+			ID:      uint32(id),
+			Address: net.JoinHostPort(addr, port),
+			State:   state,
+			Zone:    val.GetZone(),
+		})
+	}
+
+	if err := lbmap.Backend4MapV3.DumpWithCallbackIfExists(parseBackendEntry); err != nil {
+		Fatalf("Unable to dump IPv4 backends table: %s", err)
+	}
+	if err := lbmap.Backend6MapV3.DumpWithCallbackIfExists(parseBackendEntry); err != nil {
+		Fatalf("Unable to dump IPv6 backends table: %s", err)
+	}
+}
+
+func exportRevNat(export *lbExport) {
+	parseRevNatEntry := func(key bpf.MapKey, value bpf.MapValue) {
+		revNatKey := key.(lbmap.RevNatKey).ToHost()
+		revNatVal := value.(lbmap.RevNatValue).ToHost()
+
+		export.RevNat = append(export.RevNat, exportedRevNat{
+			ID:      revNatKey.GetKey(),
+			Address: revNatVal.String(),
+		})
+	}
+
+	if err := lbmap.RevNat4MapV2.DumpWithCallbackIfExists(parseRevNatEntry); err != nil {
+		Fatalf("Unable to dump IPv4 reverse NAT table: %s", err)
+	}
+	if err := lbmap.RevNat6MapV2.DumpWithCallbackIfExists(parseRevNatEntry); err != nil {
+		Fatalf("Unable to dump IPv6 reverse NAT table: %s", err)
+	}
+}
+
+func exportSourceRanges(export *lbExport) {
+	parseSourceRangeEntry := func(key bpf.MapKey, _ bpf.MapValue) {
+		srcRangeKey := key.(lbmap.SourceRangeKey).ToHost()
+
+		export.SourceRanges = append(export.SourceRanges, exportedSourceRange{
+			CIDR:     srcRangeKey.GetCIDR().String(),
+			RevNatID: srcRangeKey.GetRevNATID(),
+		})
+	}
+
+	if err := lbmap.SourceRange4Map.DumpWithCallbackIfExists(parseSourceRangeEntry); err != nil {
+		Fatalf("Unable to dump IPv4 source range table: %s", err)
+	}
+	if err := lbmap.SourceRange6Map.DumpWithCallbackIfExists(parseSourceRangeEntry); err != nil {
+		Fatalf("Unable to dump IPv6 source range table: %s", err)
+	}
+}
+
+func exportAffinityMatch(export *lbExport) {
+	parseAffinityMatchEntry := func(key bpf.MapKey, _ bpf.MapValue) {
+		matchKey := key.(*lbmap.AffinityMatchKey).ToHost()
+
+		export.AffinityMatch = append(export.AffinityMatch, exportedAffinityMatch{
+			BackendID: uint32(matchKey.BackendID),
+			RevNatID:  matchKey.RevNATID,
+		})
+	}
+
+	if err := lbmap.AffinityMatchMap.DumpWithCallbackIfExists(parseAffinityMatchEntry); err != nil {
+		Fatalf("Unable to dump affinity match table: %s", err)
+	}
+}
+
+// bpfLBExportCmd represents the bpf_lb_export command
+var bpfLBExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export load-balancing configuration as JSON",
+	Long:  "Serialize all LB maps (host byte order, resolved flags) into a single JSON document, suitable for offline analysis and inclusion in sysdumps",
+	Run: func(cmd *cobra.Command, args []string) {
+		common.RequireRootPrivilege("cilium bpf lb export")
+
+		// Ensure that the BPF map objects have been initialized before trying
+		// to dump them. Note, this is _not_ creating a new map, but rather
+		// initializing the Go object representing the map. We don't need to
+		// pass the correct sizes here because once the maps are opened, their
+		// size will be read.
+		lbmap.Init(lbmap.InitParams{IPv4: true, IPv6: true})
+
+		export := lbExport{
+			Services:      []exportedService{},
+			Backends:      []exportedBackend{},
+			RevNat:        []exportedRevNat{},
+			SourceRanges:  []exportedSourceRange{},
+			AffinityMatch: []exportedAffinityMatch{},
+		}
+
+		exportServices(&export)
+		exportBackends(&export)
+		exportRevNat(&export)
+		exportSourceRanges(&export)
+		exportAffinityMatch(&export)
+
+		out, err := json.MarshalIndent(export, "", "  ")
+		if err != nil {
+			Fatalf("Unable to generate JSON output: %s", err)
+		}
+		fmt.Println(string(out))
+	},
+}
+
+func init() {
+	BPFLBCmd.AddCommand(bpfLBExportCmd)
+}
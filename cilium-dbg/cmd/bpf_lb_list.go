@@ -38,10 +38,10 @@ func dumpSrcRanges(serviceList map[string][]string) {
 }
 
 func dumpRevNat(serviceList map[string][]string) {
-	if err := lbmap.RevNat4Map.DumpIfExists(serviceList); err != nil {
+	if err := lbmap.RevNat4MapV2.DumpIfExists(serviceList); err != nil {
 		Fatalf("Unable to dump IPv4 reverse NAT table: %s", err)
 	}
-	if err := lbmap.RevNat6Map.DumpIfExists(serviceList); err != nil {
+	if err := lbmap.RevNat6MapV2.DumpIfExists(serviceList); err != nil {
 		Fatalf("Unable to dump IPv6 reverse NAT table: %s", err)
 	}
 }
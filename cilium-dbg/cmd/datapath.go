@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// DatapathCmd represents the datapath command
+var DatapathCmd = &cobra.Command{
+	Use:   "datapath",
+	Short: "Direct access to the local datapath state",
+}
+
+func init() {
+	RootCmd.AddCommand(DatapathCmd)
+}
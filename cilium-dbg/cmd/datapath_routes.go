@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/cilium/cilium/pkg/command"
+	"github.com/cilium/cilium/pkg/common"
+	"github.com/cilium/cilium/pkg/datapath/linux/linux_defaults"
+)
+
+// datapathEntry is a route, rule or neighbor entry owned by Cilium
+type datapathEntry struct {
+	Kind   string `json:"kind"`
+	Target string `json:"target"`
+	Device string `json:"device,omitempty"`
+	Table  int    `json:"table,omitempty"`
+}
+
+var datapathRoutesCmd = &cobra.Command{
+	Use:   "routes",
+	Short: "List routes, rules and neighbor entries installed by Cilium",
+	Long: `List the node-local routes, IP rules and neighbor entries which are
+owned by Cilium. Routes are matched on Cilium-specific routing tables and
+Cilium-owned devices, neighbor entries on the externally learned flag, so
+entries installed by other agents using the same conventions may show up
+as well.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		common.RequireRootPrivilege("cilium datapath routes")
+
+		entries, err := listDatapathEntries()
+		if err != nil {
+			Fatalf("Unable to list datapath entries: %s", err)
+		}
+
+		if command.OutputOption() {
+			if err := command.PrintOutput(entries); err != nil {
+				os.Exit(1)
+			}
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 5, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "KIND\tTARGET\tDEVICE\tTABLE")
+		for _, e := range entries {
+			table := ""
+			if e.Table != 0 {
+				table = fmt.Sprintf("%d", e.Table)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.Kind, e.Target, e.Device, table)
+		}
+		w.Flush()
+	},
+}
+
+// isCiliumRouteTable returns true if the given routing table ID is one of the
+// tables Cilium installs routes into.
+func isCiliumRouteTable(table int) bool {
+	switch table {
+	case linux_defaults.RouteTableIPSec,
+		linux_defaults.RouteTableVtep,
+		linux_defaults.RouteTableToProxy,
+		linux_defaults.RouteTableFromProxy:
+		return true
+	}
+	return false
+}
+
+func listDatapathEntries() ([]datapathEntry, error) {
+	linkNames := map[int]string{}
+	links, err := netlink.LinkList()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list links: %w", err)
+	}
+	for _, l := range links {
+		linkNames[l.Attrs().Index] = l.Attrs().Name
+	}
+
+	var entries []datapathEntry
+
+	routes, err := netlink.RouteListFiltered(netlink.FAMILY_ALL,
+		&netlink.Route{Table: unix.RT_TABLE_UNSPEC}, netlink.RT_FILTER_TABLE)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list routes: %w", err)
+	}
+	for _, r := range routes {
+		device := linkNames[r.LinkIndex]
+		if !isCiliumRouteTable(r.Table) && !strings.HasPrefix(device, "cilium") {
+			continue
+		}
+		target := "default"
+		if r.Dst != nil {
+			target = r.Dst.String()
+		}
+		entries = append(entries, datapathEntry{
+			Kind:   "route",
+			Target: target,
+			Device: device,
+			Table:  r.Table,
+		})
+	}
+
+	rules, err := netlink.RuleListFiltered(netlink.FAMILY_ALL, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list rules: %w", err)
+	}
+	for _, r := range rules {
+		if !isCiliumRouteTable(r.Table) {
+			continue
+		}
+		entries = append(entries, datapathEntry{
+			Kind:   "rule",
+			Target: r.String(),
+			Table:  r.Table,
+		})
+	}
+
+	neighbors, err := netlink.NeighList(0, netlink.FAMILY_ALL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list neighbors: %w", err)
+	}
+	for _, n := range neighbors {
+		// Cilium installs its neighbor entries as externally learned
+		if n.Flags&netlink.NTF_EXT_LEARNED == 0 {
+			continue
+		}
+		entries = append(entries, datapathEntry{
+			Kind:   "neighbor",
+			Target: n.IP.String(),
+			Device: linkNames[n.LinkIndex],
+		})
+	}
+
+	return entries, nil
+}
+
+func init() {
+	DatapathCmd.AddCommand(datapathRoutesCmd)
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/cilium/pkg/kvstore"
+)
+
+var kvstoreSnapshotFile string
+
+var kvstoreSnapshotCmd = &cobra.Command{
+	Use:     "snapshot [options] [prefix]",
+	Short:   "Export a consistent snapshot of the keys matching the prefix",
+	Example: "cilium kvstore snapshot --output snapshot.json cilium/",
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := kvstore.BaseKeyPrefix
+		if len(args) > 0 {
+			prefix = args[0]
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		setupKvstore(ctx)
+
+		snapshotter, ok := kvstore.Client().(kvstore.BackendSnapshotter)
+		if !ok {
+			Fatalf("The configured kvstore backend does not support snapshots")
+		}
+
+		snapshot, err := snapshotter.ExportSnapshot(ctx, prefix)
+		if err != nil {
+			Fatalf("Unable to export snapshot: %s", err)
+		}
+
+		out := os.Stdout
+		if kvstoreSnapshotFile != "" {
+			out, err = os.Create(kvstoreSnapshotFile)
+			if err != nil {
+				Fatalf("Unable to create output file: %s", err)
+			}
+			defer out.Close()
+		}
+
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(snapshot); err != nil {
+			Fatalf("Unable to write snapshot: %s", err)
+		}
+
+		if kvstoreSnapshotFile != "" {
+			fmt.Printf("Exported %d keys at revision %d to %s\n",
+				len(snapshot.Entries), snapshot.Revision, kvstoreSnapshotFile)
+		}
+	},
+}
+
+func init() {
+	kvstoreCmd.AddCommand(kvstoreSnapshotCmd)
+	kvstoreSnapshotCmd.Flags().StringVarP(&kvstoreSnapshotFile, "output", "o", "", "Write the snapshot to the given file instead of the standard output")
+}
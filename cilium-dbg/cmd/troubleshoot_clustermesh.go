@@ -79,7 +79,7 @@ func TroubleshootClusterMesh(
 		}
 
 		cctx, cancel := context.WithTimeout(ctx, timeout)
-		kvstore.EtcdDbg(cctx, cfg, dialer, stdout)
+		kvstore.EtcdDbgCluster(cctx, cfg, cluster, dialer, stdout)
 		cancel()
 	}
 }
@@ -62,6 +62,7 @@ var Cell = cell.Module(
 	cmmetrics.Cell,
 
 	usersManagementCell,
+	cell.Config(SyncConfig{}),
 	cell.Invoke(registerHooks),
 	externalWorkloadsCell,
 )
@@ -14,6 +14,7 @@ import (
 	"github.com/cilium/hive/cell"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	cmk8s "github.com/cilium/cilium/clustermesh-apiserver/clustermesh/k8s"
@@ -73,10 +74,21 @@ func NewCmd(h *hive.Hive) *cobra.Command {
 	return rootCmd
 }
 
+// SyncConfig tunes the synchronization of resources towards the kvstore.
+type SyncConfig struct {
+	EnableCompressedSync bool
+}
+
+func (def SyncConfig) Flags(flags *pflag.FlagSet) {
+	flags.Bool("enable-compressed-sync", def.EnableCompressedSync,
+		"Enable the compression of high-volume synchronized values (ipcache, identities). All remote agents must support transparent decompression")
+}
+
 type parameters struct {
 	cell.In
 
 	ExternalWorkloadsConfig
+	SyncConfig
 	ClusterInfo    cmtypes.ClusterInfo
 	Clientset      k8sClient.Clientset
 	Resources      cmk8s.Resources
@@ -97,7 +109,7 @@ func registerHooks(lc cell.Lifecycle, params parameters) error {
 				return err
 			}
 
-			startServer(ctx, params.ClusterInfo, params.EnableExternalWorkloads, params.Clientset, backend, params.Resources, params.StoreFactory, params.SyncState)
+			startServer(ctx, params.ClusterInfo, params.EnableExternalWorkloads, params.SyncConfig, params.Clientset, backend, params.Resources, params.StoreFactory, params.SyncState)
 			return nil
 		},
 	})
@@ -110,10 +122,10 @@ type identitySynchronizer struct {
 	syncCallback func(context.Context)
 }
 
-func newIdentitySynchronizer(ctx context.Context, cinfo cmtypes.ClusterInfo, backend kvstore.BackendOperations, factory store.Factory, syncCallback func(context.Context)) synchronizer {
+func newIdentitySynchronizer(ctx context.Context, cinfo cmtypes.ClusterInfo, backend kvstore.BackendOperations, factory store.Factory, syncCallback func(context.Context), opts ...store.WSSOpt) synchronizer {
 	identitiesStore := factory.NewSyncStore(cinfo.Name, backend,
 		path.Join(identityCache.IdentitiesPath, "id"),
-		store.WSSWithSyncedKeyOverride(identityCache.IdentitiesPath))
+		append([]store.WSSOpt{store.WSSWithSyncedKeyOverride(identityCache.IdentitiesPath)}, opts...)...)
 	go identitiesStore.Run(ctx)
 
 	return &identitySynchronizer{store: identitiesStore, encoder: backend.Encode, syncCallback: syncCallback}
@@ -239,10 +251,10 @@ type endpointSynchronizer struct {
 	syncCallback func(context.Context)
 }
 
-func newEndpointSynchronizer(ctx context.Context, cinfo cmtypes.ClusterInfo, backend kvstore.BackendOperations, factory store.Factory, syncCallback func(context.Context)) synchronizer {
+func newEndpointSynchronizer(ctx context.Context, cinfo cmtypes.ClusterInfo, backend kvstore.BackendOperations, factory store.Factory, syncCallback func(context.Context), opts ...store.WSSOpt) synchronizer {
 	endpointsStore := factory.NewSyncStore(cinfo.Name, backend,
 		path.Join(ipcache.IPIdentitiesPath, ipcache.DefaultAddressSpace),
-		store.WSSWithSyncedKeyOverride(ipcache.IPIdentitiesPath))
+		append([]store.WSSOpt{store.WSSWithSyncedKeyOverride(ipcache.IPIdentitiesPath)}, opts...)...)
 	go endpointsStore.Run(ctx)
 
 	return &endpointSynchronizer{
@@ -347,6 +359,7 @@ func startServer(
 	startCtx cell.HookContext,
 	cinfo cmtypes.ClusterInfo,
 	allServices bool,
+	syncCfg SyncConfig,
 	clientset k8sClient.Clientset,
 	backend kvstore.BackendOperations,
 	resources cmk8s.Resources,
@@ -365,18 +378,29 @@ func startServer(
 		Capabilities: cmtypes.CiliumClusterConfigCapabilities{
 			SyncedCanaries:       true,
 			MaxConnectedClusters: cinfo.MaxConnectedClusters,
+			CompressedSync:       syncCfg.EnableCompressedSync,
 		},
 	}
 
+	if syncCfg.EnableCompressedSync {
+		// Readers must support transparent decompression of the synced values.
+		config.Capabilities.RequiredFeatures = []string{cmtypes.FeatureCompressedSync}
+	}
+
 	_, err := cmutils.EnforceClusterConfig(context.Background(), cinfo.Name, config, backend, log)
 	if err != nil {
 		log.WithError(err).Fatal("Unable to set local cluster config on kvstore")
 	}
 
+	var wssOpts []store.WSSOpt
+	if syncCfg.EnableCompressedSync {
+		wssOpts = append(wssOpts, store.WSSWithCompressedValues())
+	}
+
 	ctx := context.Background()
-	go synchronize(ctx, resources.CiliumIdentities, newIdentitySynchronizer(ctx, cinfo, backend, factory, syncState.WaitForResource()))
+	go synchronize(ctx, resources.CiliumIdentities, newIdentitySynchronizer(ctx, cinfo, backend, factory, syncState.WaitForResource(), wssOpts...))
 	go synchronize(ctx, resources.CiliumNodes, newNodeSynchronizer(ctx, cinfo, backend, factory, syncState.WaitForResource()))
-	go synchronize(ctx, resources.CiliumSlimEndpoints, newEndpointSynchronizer(ctx, cinfo, backend, factory, syncState.WaitForResource()))
+	go synchronize(ctx, resources.CiliumSlimEndpoints, newEndpointSynchronizer(ctx, cinfo, backend, factory, syncState.WaitForResource(), wssOpts...))
 	operatorWatchers.StartSynchronizingServices(ctx, &sync.WaitGroup{}, operatorWatchers.ServiceSyncParameters{
 		ClusterInfo:  cinfo,
 		Clientset:    clientset,
@@ -907,6 +907,10 @@ func newDaemon(ctx context.Context, cleaner *daemonCleanup, params *daemonParams
 		return nil, restoredEndpoints, fmt.Errorf("error while initializing daemon: %w", err)
 	}
 
+	// The LB maps have been created/opened by d.init(), so the pressure of
+	// all of them can now be tracked.
+	lbmap.CalculatePressure(d.controllers)
+
 	// iptables rules can be updated only after d.init() intializes the iptables above.
 	err = d.updateDNSDatapathRules(d.ctx)
 	if err != nil {
@@ -44,6 +44,7 @@ import (
 	"github.com/cilium/cilium/pkg/datapath/linux/bigtcp"
 	"github.com/cilium/cilium/pkg/datapath/linux/ipsec"
 	"github.com/cilium/cilium/pkg/datapath/linux/probes"
+	datapathRoute "github.com/cilium/cilium/pkg/datapath/linux/route"
 	linuxrouting "github.com/cilium/cilium/pkg/datapath/linux/routing"
 	"github.com/cilium/cilium/pkg/datapath/linux/sysctl"
 	"github.com/cilium/cilium/pkg/datapath/maps"
@@ -1313,6 +1314,12 @@ func initEnv(vp *viper.Viper) {
 	bpf.CheckOrMountFS(option.Config.BPFRoot)
 	cgroups.CheckOrMountCgrpFS(option.Config.CGroupRoot)
 
+	// Fence off any other agent instance accidentally running against the
+	// same bpffs before any BPF map is mutated.
+	if err := bpf.AcquireMapOwnership(); err != nil {
+		scopedLog.WithError(err).Fatal("Unable to claim BPF map ownership")
+	}
+
 	option.Config.Opts.SetBool(option.Debug, debugDatapath)
 	option.Config.Opts.SetBool(option.DebugLB, debugDatapath)
 	option.Config.Opts.SetBool(option.DropNotify, option.Config.BPFEventsDropEnabled)
@@ -1880,6 +1887,14 @@ func startDaemon(d *Daemon, restoredEndpoints *endpointRestoreState, cleaner *da
 		log.WithError(err).Warn("Failed to send agent start monitor message")
 	}
 
+	// Forward route, rule and neighbor programming decisions to the monitor
+	// so they can be consumed as agent events.
+	datapathRoute.SetMonitorNotify(func(msg monitorAPI.AgentNotifyMessage) {
+		if err := d.SendNotification(msg); err != nil {
+			log.WithError(err).Debug("Failed to send datapath update monitor message")
+		}
+	})
+
 	// Watches for node neighbors link updates.
 	d.nodeDiscovery.Manager.StartNodeNeighborLinkUpdater(d.datapath.NodeNeighbors())
 
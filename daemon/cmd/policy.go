@@ -20,6 +20,7 @@ import (
 	. "github.com/cilium/cilium/api/v1/server/restapi/policy"
 	"github.com/cilium/cilium/pkg/api"
 	"github.com/cilium/cilium/pkg/clustermesh"
+	"github.com/cilium/cilium/pkg/clustermesh/common"
 	cmtypes "github.com/cilium/cilium/pkg/clustermesh/types"
 	"github.com/cilium/cilium/pkg/crypto/certificatemanager"
 	"github.com/cilium/cilium/pkg/endpoint"
@@ -74,6 +75,10 @@ type policyParams struct {
 	SecretManager   certificatemanager.SecretManager
 	CacheStatus     k8s.CacheStatus
 	ClusterInfo     cmtypes.ClusterInfo
+
+	// ClusterMeshConfig enables the relevance filtering of remote identities.
+	// It is optional to not require the clustermesh cell in all hives.
+	ClusterMeshConfig common.Config `optional:"true"`
 }
 
 type policyOut struct {
@@ -128,6 +133,15 @@ func newPolicyTrifecta(params policyParams) (policyOut, error) {
 	idAlloc := cache.NewCachingIdentityAllocator(iao)
 	idAlloc.EnableCheckpointing()
 
+	if params.ClusterMeshConfig.ClusterMeshIdentityRelevanceFiltering {
+		// Only cache the remote identities actually referenced by local
+		// policy selectors, fetching the others on demand upon lookup.
+		sc := repo.GetSelectorCache()
+		idAlloc.SetRemoteIdentityRelevance(func(nid identity.NumericIdentity, lbls labels.LabelArray) bool {
+			return sc.MatchesAnySelector(nid, lbls)
+		})
+	}
+
 	// IPCache: aggregates node-local prefix labels and allocates
 	// local identities. Generates incremental updates, pushes
 	// to endpoints.
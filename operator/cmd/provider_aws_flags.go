@@ -39,6 +39,9 @@ func (hook *awsFlagsHooks) RegisterProviderFlag(cmd *cobra.Command, vp *viper.Vi
 	flags.Int(operatorOption.ExcessIPReleaseDelay, 180, "Number of seconds operator would wait before it releases an IP previously marked as excess")
 	option.BindEnv(vp, operatorOption.ExcessIPReleaseDelay)
 
+	flags.Int(operatorOption.ExcessIPReleaseBatchSize, 0, "Maximum number of excess IPs released per pool maintenance interval (0 for no limit)")
+	option.BindEnv(vp, operatorOption.ExcessIPReleaseBatchSize)
+
 	flags.Bool(operatorOption.AWSEnablePrefixDelegation, false, "Allows operator to allocate prefixes to ENIs instead of individual IP addresses")
 	option.BindEnv(vp, operatorOption.AWSEnablePrefixDelegation)
 
@@ -46,6 +49,10 @@ func (hook *awsFlagsHooks) RegisterProviderFlag(cmd *cobra.Command, vp *viper.Vi
 		operatorOption.ENITags, "ENI tags in the form of k1=v1 (multiple k/v pairs can be passed by repeating the CLI flag)")
 	option.BindEnv(vp, operatorOption.ENITags)
 
+	flags.String(operatorOption.ENINameTemplate, "",
+		"Name tag template for ENIs created by Cilium, e.g. \"cilium-eni-{cluster-name}\". The {cluster-name} placeholder is replaced with the Cilium cluster name. Takes precedence over a Name entry in --eni-tags")
+	option.BindEnv(vp, operatorOption.ENINameTemplate)
+
 	flags.Var(option.NewNamedMapOptions(operatorOption.ENIGarbageCollectionTags, &operatorOption.Config.ENIGarbageCollectionTags, nil),
 		operatorOption.ENIGarbageCollectionTags, "Additional tags attached to ENIs created by Cilium. Dangling ENIs with this tag will be garbage collected")
 	option.BindEnv(vp, operatorOption.ENIGarbageCollectionTags)
@@ -60,8 +67,17 @@ func (hook *awsFlagsHooks) RegisterProviderFlag(cmd *cobra.Command, vp *viper.Vi
 	flags.Bool(operatorOption.AWSUsePrimaryAddress, false, "Allows for using primary address of the ENI for allocations on the node")
 	option.BindEnv(vp, operatorOption.AWSUsePrimaryAddress)
 
-	flags.String(operatorOption.EC2APIEndpoint, "", "AWS API endpoint for the EC2 service")
+	flags.String(operatorOption.AWSAssumeRoleARN, "", "ARN of an IAM role to assume for all EC2 operations, e.g. to manage ENIs owned by a different account in shared VPC setups")
+	option.BindEnv(vp, operatorOption.AWSAssumeRoleARN)
+
+	flags.String(operatorOption.EC2APIEndpoint, "", "AWS API endpoint for the EC2 service, e.g. a VPC interface endpoint for air-gapped VPCs")
 	option.BindEnv(vp, operatorOption.EC2APIEndpoint)
 
+	flags.Bool(operatorOption.EC2UseDualStackEndpoint, false, "Use the dual-stack (IPv4 and IPv6) endpoints of the EC2 service")
+	option.BindEnv(vp, operatorOption.EC2UseDualStackEndpoint)
+
+	flags.Duration(operatorOption.EC2APICacheTTL, 0, "Time to live of cached EC2 describe API results used during resync. Reduces the number of API calls in large clusters at the cost of results being up to the TTL stale. Set to 0 to disable caching")
+	option.BindEnv(vp, operatorOption.EC2APICacheTTL)
+
 	vp.BindPFlags(flags)
 }
@@ -132,6 +132,10 @@ const (
 	// Defaults to 180 secs
 	ExcessIPReleaseDelay = "excess-ip-release-delay"
 
+	// ExcessIPReleaseBatchSize limits how many excess IPs are released per
+	// pool maintenance interval. 0 means no limit.
+	ExcessIPReleaseBatchSize = "excess-ip-release-batch-size"
+
 	// AWSEnablePrefixDelegation allows operator to allocate prefixes to ENIs on nitro instances instead of individual
 	// IP addresses. Allows for increased pod density on nodes.
 	AWSEnablePrefixDelegation = "aws-enable-prefix-delegation"
@@ -140,6 +144,10 @@ const (
 	// AWS ENI IPAM.
 	ENITags = "eni-tags"
 
+	// ENINameTemplate is the template for the Name tag applied to every
+	// ENI created by the AWS ENI IPAM.
+	ENINameTemplate = "eni-name-template"
+
 	// ENIGarbageCollectionTags is a tag that will be added to every ENI
 	// created by the AWS ENI IPAM.
 	// Any stale and unattached ENIs with this tag will be garbage
@@ -160,10 +168,23 @@ const (
 	// e.g. "ec2-fips.us-west-1.amazonaws.com" to use a FIPS endpoint in the us-west-1 region.
 	EC2APIEndpoint = "ec2-api-endpoint"
 
+	// EC2UseDualStackEndpoint configures the operator to use the
+	// dual-stack (IPv4 and IPv6) endpoints of the EC2 AWS service.
+	EC2UseDualStackEndpoint = "ec2-use-dual-stack-endpoint"
+
+	// EC2APICacheTTL is the time to live of cached EC2 describe API results
+	// used during resync. Caching is disabled if set to 0.
+	EC2APICacheTTL = "ec2-api-cache-ttl"
+
 	// AWSUsePrimaryAddress specifies whether an interface's primary address should be available for allocations on
 	// node
 	AWSUsePrimaryAddress = "aws-use-primary-address"
 
+	// AWSAssumeRoleARN is the ARN of an IAM role which is assumed for all
+	// EC2 operations, e.g. to manage ENIs and subnets owned by a different
+	// account in shared VPC setups.
+	AWSAssumeRoleARN = "aws-assume-role-arn"
+
 	// Azure options
 
 	// AzureSubscriptionID is the subscription ID to use when accessing the Azure API
@@ -322,6 +343,11 @@ type OperatorConfig struct {
 	// ENITags are the tags that will be added to every ENI created by the AWS ENI IPAM
 	ENITags map[string]string
 
+	// ENINameTemplate is the template for the Name tag applied to every ENI
+	// created by the AWS ENI IPAM. The {cluster-name} placeholder is replaced
+	// with the Cilium cluster name.
+	ENINameTemplate string
+
 	// ENIGarbageCollectionTags is a tag that will be added to every ENI
 	// created by the AWS ENI IPAM.
 	// Any stale and unattached ENIs with this tag will be garbage
@@ -352,6 +378,11 @@ type OperatorConfig struct {
 	// node
 	AWSUsePrimaryAddress bool
 
+	// AWSAssumeRoleARN is the ARN of an IAM role which is assumed for all
+	// EC2 operations, e.g. to manage ENIs and subnets owned by a different
+	// account in shared VPC setups.
+	AWSAssumeRoleARN string
+
 	// UpdateEC2AdapterLimitViaAPI configures the operator to use the EC2 API to fill out the
 	// instancetype to adapter limit mapping.
 	UpdateEC2AdapterLimitViaAPI bool
@@ -360,10 +391,22 @@ type OperatorConfig struct {
 	// Defaults to 180 secs
 	ExcessIPReleaseDelay int
 
+	// ExcessIPReleaseBatchSize limits how many excess IPs are released per
+	// pool maintenance interval. 0 means no limit.
+	ExcessIPReleaseBatchSize int
+
 	// EC2APIEndpoint is the custom API endpoint to use for the EC2 AWS service,
 	// e.g. "ec2-fips.us-west-1.amazonaws.com" to use a FIPS endpoint in the us-west-1 region.
 	EC2APIEndpoint string
 
+	// EC2UseDualStackEndpoint configures the operator to use the
+	// dual-stack (IPv4 and IPv6) endpoints of the EC2 AWS service.
+	EC2UseDualStackEndpoint bool
+
+	// EC2APICacheTTL is the time to live of cached EC2 describe API results
+	// used during resync. Caching is disabled if set to 0.
+	EC2APICacheTTL time.Duration
+
 	// Azure options
 
 	// AzureSubscriptionID is the subscription ID to use when accessing the Azure API
@@ -478,9 +521,14 @@ func (c *OperatorConfig) Populate(vp *viper.Viper) {
 	c.AWSReleaseExcessIPs = vp.GetBool(AWSReleaseExcessIPs)
 	c.AWSEnablePrefixDelegation = vp.GetBool(AWSEnablePrefixDelegation)
 	c.AWSUsePrimaryAddress = vp.GetBool(AWSUsePrimaryAddress)
+	c.AWSAssumeRoleARN = vp.GetString(AWSAssumeRoleARN)
 	c.UpdateEC2AdapterLimitViaAPI = vp.GetBool(UpdateEC2AdapterLimitViaAPI)
 	c.EC2APIEndpoint = vp.GetString(EC2APIEndpoint)
+	c.EC2UseDualStackEndpoint = vp.GetBool(EC2UseDualStackEndpoint)
+	c.EC2APICacheTTL = vp.GetDuration(EC2APICacheTTL)
+	c.ENINameTemplate = vp.GetString(ENINameTemplate)
 	c.ExcessIPReleaseDelay = vp.GetInt(ExcessIPReleaseDelay)
+	c.ExcessIPReleaseBatchSize = vp.GetInt(ExcessIPReleaseBatchSize)
 	c.ENIGarbageCollectionInterval = vp.GetDuration(ENIGarbageCollectionInterval)
 
 	// Azure options
@@ -11,6 +11,7 @@ import (
 	"math/big"
 	"net/netip"
 	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -43,6 +44,7 @@ const (
 	ciliumPoolIPsAvailableCondition = "cilium.io/IPsAvailable"
 	ciliumPoolIPsUsedCondition      = "cilium.io/IPsUsed"
 	ciliumPoolConflict              = "cilium.io/PoolConflict"
+	ciliumPoolNamespaceQuota        = "cilium.io/NamespaceQuotaReached"
 
 	ciliumSvcLBISKCNWildward = "*"
 
@@ -53,6 +55,10 @@ const (
 	serviceNameLabel      = "io.kubernetes.service.name"
 )
 
+// errNamespaceQuotaReached indicates that all matching pools have reached the
+// per-namespace quota configured via `maxIPsPerNamespace`.
+var errNamespaceQuotaReached = errors.New("namespace quota reached")
+
 var (
 	// eventsOpts are the options used with resource's Events()
 	eventsOpts = resource.WithRateLimiter(
@@ -885,6 +891,15 @@ func (ipam *LBIPAM) satisfySpecificIPRequests(sv *ServiceView) (statusModified b
 				continue
 			}
 		} else {
+			if pool, found := ipam.pools[lbRange.originPool]; found && ipam.namespaceQuotaReached(pool, sv.Key.Namespace) {
+				msg := fmt.Sprintf("The pool with the CIDR containing '%s' reached the quota for this namespace", reqIP)
+				reason := "namespace_quota_reached"
+				if ipam.setSVCSatisfiedCondition(sv, false, reason, msg) {
+					statusModified = true
+				}
+				continue
+			}
+
 			ipam.logger.Debugf("Allocate '%s' for '%s'", reqIP.String(), sv.Key.String())
 			err = lbRange.alloc.Alloc(reqIP, []*ServiceView{sv})
 			if err != nil {
@@ -973,7 +988,7 @@ func (ipam *LBIPAM) satisfyGenericIPv4Requests(sv *ServiceView) (statusModified
 
 	// Unable to share an already allocated IP, so lets allocate a new one
 	newIP, lbRange, err := ipam.allocateIPAddress(sv, IPv4Family)
-	if err != nil && !errors.Is(err, ipalloc.ErrFull) {
+	if err != nil && !errors.Is(err, ipalloc.ErrFull) && !errors.Is(err, errNamespaceQuotaReached) {
 		return statusModified, fmt.Errorf("allocateIPAddress: %w", err)
 	}
 	if newIP.Compare(netip.Addr{}) != 0 {
@@ -987,6 +1002,9 @@ func (ipam *LBIPAM) satisfyGenericIPv4Requests(sv *ServiceView) (statusModified
 		if errors.Is(err, ipalloc.ErrFull) {
 			reason = "out_of_ips"
 			message = "All enabled CiliumLoadBalancerIPPools that match this service ran out of allocatable IPs"
+		} else if errors.Is(err, errNamespaceQuotaReached) {
+			reason = "namespace_quota_reached"
+			message = "All enabled CiliumLoadBalancerIPPools that match this service reached the quota for this namespace"
 		}
 
 		if ipam.setSVCSatisfiedCondition(sv, false, reason, message) {
@@ -1039,7 +1057,7 @@ func (ipam *LBIPAM) satisfyGenericIPv6Requests(sv *ServiceView) (statusModified
 	}
 	if !allocatedFromSharingKey {
 		newIP, lbRange, err := ipam.allocateIPAddress(sv, IPv6Family)
-		if err != nil && !errors.Is(err, ipalloc.ErrFull) {
+		if err != nil && !errors.Is(err, ipalloc.ErrFull) && !errors.Is(err, errNamespaceQuotaReached) {
 			return statusModified, fmt.Errorf("allocateIPAddress: %w", err)
 		}
 		if newIP.Compare(netip.Addr{}) != 0 {
@@ -1053,6 +1071,9 @@ func (ipam *LBIPAM) satisfyGenericIPv6Requests(sv *ServiceView) (statusModified
 			if errors.Is(err, ipalloc.ErrFull) {
 				reason = "out_of_ips"
 				message = "All enabled CiliumLoadBalancerIPPools that match this service ran out of allocatable IPs"
+			} else if errors.Is(err, errNamespaceQuotaReached) {
+				reason = "namespace_quota_reached"
+				message = "All enabled CiliumLoadBalancerIPPools that match this service reached the quota for this namespace"
 			}
 
 			if ipam.setSVCSatisfiedCondition(sv, false, reason, message) {
@@ -1155,6 +1176,37 @@ const (
 	IPv6Family AddressFamily = "IPv6"
 )
 
+// poolUsagePerNamespace returns the amount of IPs from the given pool which
+// are currently allocated to services, grouped by service namespace.
+func (ipam *LBIPAM) poolUsagePerNamespace(poolName string) map[string]int64 {
+	usage := make(map[string]int64)
+	count := func(sv *ServiceView) {
+		for _, alloc := range sv.AllocatedIPs {
+			if alloc.Origin.originPool == poolName {
+				usage[sv.Key.Namespace]++
+			}
+		}
+	}
+	for _, sv := range ipam.serviceStore.satisfied {
+		count(sv)
+	}
+	for _, sv := range ipam.serviceStore.unsatisfied {
+		count(sv)
+	}
+	return usage
+}
+
+// namespaceQuotaReached returns whether the given namespace has used up the
+// per-namespace quota of the given pool. Pools without a configured quota
+// never run out.
+func (ipam *LBIPAM) namespaceQuotaReached(pool *cilium_api_v2alpha1.CiliumLoadBalancerIPPool, namespace string) bool {
+	if pool.Spec.MaxIPsPerNamespace == nil || *pool.Spec.MaxIPsPerNamespace == 0 {
+		return false
+	}
+
+	return ipam.poolUsagePerNamespace(pool.Name)[namespace] >= *pool.Spec.MaxIPsPerNamespace
+}
+
 func (ipam *LBIPAM) allocateIPAddress(
 	sv *ServiceView,
 	family AddressFamily,
@@ -1164,6 +1216,7 @@ func (ipam *LBIPAM) allocateIPAddress(
 	err error,
 ) {
 	full := false
+	quotaReached := false
 	for _, lbRange := range ipam.rangesStore.ranges {
 		// If the range is disabled we can't allocate new IPs from it.
 		if lbRange.Disabled() {
@@ -1200,6 +1253,13 @@ func (ipam *LBIPAM) allocateIPAddress(
 			}
 		}
 
+		// Don't allocate from this range if the namespace of the service
+		// has used up the per-namespace quota of the pool.
+		if ipam.namespaceQuotaReached(pool, sv.Key.Namespace) {
+			quotaReached = true
+			continue
+		}
+
 		// Attempt to allocate the next IP from this range.
 		newIp, err := lbRange.alloc.AllocAny([]*ServiceView{sv})
 		if err != nil {
@@ -1220,6 +1280,10 @@ func (ipam *LBIPAM) allocateIPAddress(
 		return netip.Addr{}, nil, ipalloc.ErrFull
 	}
 
+	if quotaReached {
+		return netip.Addr{}, nil, errNamespaceQuotaReached
+	}
+
 	return netip.Addr{}, nil, nil
 }
 
@@ -1598,6 +1662,10 @@ func (ipam *LBIPAM) updatePoolCounts(pool *cilium_api_v2alpha1.CiliumLoadBalance
 		modifiedPoolStatus = true
 	}
 
+	if ipam.updatePoolNamespaceQuota(pool) {
+		modifiedPoolStatus = true
+	}
+
 	available, _ := new(big.Float).SetInt(totalCounts.Available).Float64()
 	ipam.metrics.AvailableIPs.WithLabelValues(pool.Name).Set(available)
 	ipam.metrics.UsedIPs.WithLabelValues(pool.Name).Set(float64(totalCounts.Used))
@@ -1605,6 +1673,31 @@ func (ipam *LBIPAM) updatePoolCounts(pool *cilium_api_v2alpha1.CiliumLoadBalance
 	return modifiedPoolStatus
 }
 
+// updatePoolNamespaceQuota reflects the per-namespace quota usage of the
+// given pool in its status, reporting the namespaces which have used up
+// their quota.
+func (ipam *LBIPAM) updatePoolNamespaceQuota(pool *cilium_api_v2alpha1.CiliumLoadBalancerIPPool) (modifiedPoolStatus bool) {
+	if pool.Spec.MaxIPsPerNamespace == nil || *pool.Spec.MaxIPsPerNamespace == 0 {
+		return meta.RemoveStatusCondition(&pool.Status.Conditions, ciliumPoolNamespaceQuota)
+	}
+
+	var exhausted []string
+	for namespace, used := range ipam.poolUsagePerNamespace(pool.Name) {
+		if used >= *pool.Spec.MaxIPsPerNamespace {
+			exhausted = append(exhausted, namespace)
+		}
+	}
+	sort.Strings(exhausted)
+
+	if len(exhausted) == 0 {
+		return ipam.setPoolCondition(pool, ciliumPoolNamespaceQuota, meta_v1.ConditionFalse, "noreason",
+			"No namespace has reached its quota")
+	}
+
+	return ipam.setPoolCondition(pool, ciliumPoolNamespaceQuota, meta_v1.ConditionTrue, "namespace_quota_reached",
+		fmt.Sprintf("Namespaces which reached their quota: %s", strings.Join(exhausted, ", ")))
+}
+
 func (ipam *LBIPAM) setPoolCondition(
 	pool *cilium_api_v2alpha1.CiliumLoadBalancerIPPool,
 	condType string,
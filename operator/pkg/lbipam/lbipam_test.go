@@ -12,6 +12,7 @@ import (
 	"time"
 
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/cilium/cilium/pkg/annotation"
 	cilium_api_v2alpha1 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2alpha1"
@@ -1993,3 +1994,63 @@ func TestRangeFromPrefix(t *testing.T) {
 		})
 	}
 }
+
+// TestNamespaceQuota tests that a pool with a per-namespace quota stops assigning IPs to services
+// in a namespace which used up its quota, while still serving other namespaces, and that the pool
+// status reports the namespaces which reached the quota.
+func TestNamespaceQuota(t *testing.T) {
+	poolA := mkPool(poolAUID, "pool-a", []string{"10.0.10.0/24"})
+	quota := int64(1)
+	poolA.Spec.MaxIPsPerNamespace = &quota
+	fixture := mkTestFixture(true, false)
+	fixture.UpsertPool(t, poolA)
+
+	mkSvc := func(name, namespace string, uid types.UID) *slim_core_v1.Service {
+		return &slim_core_v1.Service{
+			ObjectMeta: slim_meta_v1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				UID:       uid,
+			},
+			Spec: slim_core_v1.ServiceSpec{
+				Type: slim_core_v1.ServiceTypeLoadBalancer,
+			},
+		}
+	}
+
+	fixture.UpsertSvc(t, mkSvc("service-a", "default", serviceAUID))
+	svcA := fixture.GetSvc("default", "service-a")
+	if len(svcA.Status.LoadBalancer.Ingress) != 1 {
+		t.Fatal("Expected service A to receive an ingress IP")
+	}
+
+	fixture.UpsertSvc(t, mkSvc("service-b", "default", serviceBUID))
+	svcB := fixture.GetSvc("default", "service-b")
+	if len(svcB.Status.LoadBalancer.Ingress) != 0 {
+		t.Fatal("Expected service B to not receive an ingress IP")
+	}
+	if len(svcB.Status.Conditions) != 1 ||
+		svcB.Status.Conditions[0].Status != slim_meta_v1.ConditionFalse ||
+		svcB.Status.Conditions[0].Reason != "namespace_quota_reached" {
+		t.Fatal("Expected service B to have an unsatisfied condition due to the namespace quota")
+	}
+
+	// A service in another namespace is not affected by the exhausted quota of 'default'.
+	fixture.UpsertSvc(t, mkSvc("service-c", "other", serviceCUID))
+	svcC := fixture.GetSvc("other", "service-c")
+	if len(svcC.Status.LoadBalancer.Ingress) != 1 {
+		t.Fatal("Expected service C to receive an ingress IP")
+	}
+
+	poolA = fixture.GetPool("pool-a")
+	var quotaCond *meta_v1.Condition
+	for i, cond := range poolA.Status.Conditions {
+		if cond.Type == ciliumPoolNamespaceQuota {
+			quotaCond = &poolA.Status.Conditions[i]
+		}
+	}
+	if quotaCond == nil || quotaCond.Status != meta_v1.ConditionTrue ||
+		!strings.Contains(quotaCond.Message, "default") {
+		t.Fatal("Expected the pool to report that the 'default' namespace reached its quota")
+	}
+}
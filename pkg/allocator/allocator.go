@@ -167,6 +167,11 @@ type AllocatorOption func(*Allocator)
 // invalid notification events.
 type CacheValidator func(kind AllocatorChangeKind, id idpool.ID, key AllocatorKey) error
 
+// ErrKeyFiltered is the error to be wrapped by cache validators to deliberately
+// filter out a key from the local cache, without it being treated as an error
+// condition (i.e., it is logged at debug rather than warning level).
+var ErrKeyFiltered = errors.New("key filtered out")
+
 // NewAllocatorForGC returns an allocator that can be used to run RunGC()
 //
 // The allocator can be configured by passing in additional options:
@@ -818,6 +823,32 @@ func (a *Allocator) GetByIDIncludeRemoteCaches(ctx context.Context, id idpool.ID
 	return nil, nil
 }
 
+// GetByIDIncludeRemoteBackends returns the key associated with an ID,
+// additionally querying the backends of the watched remote kvstores in case
+// of cache misses. It is intended to be used when remote keys are only
+// partially cached locally (e.g., due to relevance filtering), and misses
+// are hence expected.
+func (a *Allocator) GetByIDIncludeRemoteBackends(ctx context.Context, id idpool.ID) (AllocatorKey, error) {
+	if key, err := a.GetByIDIncludeRemoteCaches(ctx, id); key != nil || err != nil {
+		return key, err
+	}
+
+	a.remoteCachesMutex.RLock()
+	remotes := make([]*RemoteCache, 0, len(a.remoteCaches))
+	for _, rc := range a.remoteCaches {
+		remotes = append(remotes, rc)
+	}
+	a.remoteCachesMutex.RUnlock()
+
+	for _, rc := range remotes {
+		if key, err := rc.allocator.backend.GetByID(ctx, id); key != nil || err != nil {
+			return key, err
+		}
+	}
+
+	return nil, nil
+}
+
 // Release releases the use of an ID associated with the provided key. After
 // the last user has released the ID, the key is removed in the KVstore and
 // the returned lastUse value is true.
@@ -1070,6 +1101,18 @@ func (rc *RemoteCache) Synced() bool {
 	}
 }
 
+// SyncedAt returns the time at which the initial list of entries was last
+// retrieved from the kvstore, or the zero time if it never completed.
+func (rc *RemoteCache) SyncedAt() time.Time {
+	if rc == nil {
+		return time.Time{}
+	}
+
+	rc.cache.mutex.RLock()
+	defer rc.cache.mutex.RUnlock()
+	return rc.cache.listDoneAt
+}
+
 // close stops watching for identities in the kvstore associated with the
 // remote cache.
 func (rc *RemoteCache) close() {
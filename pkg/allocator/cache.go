@@ -5,6 +5,7 @@ package allocator
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"github.com/cilium/stream"
@@ -58,6 +59,10 @@ type cache struct {
 
 	listDone waitChan
 
+	// listDoneAt is the timestamp of the last completed initial list
+	// operation. It is protected by mutex.
+	listDoneAt time.Time
+
 	// stopWatchWg is a wait group that gets conditions added when a
 	// watcher is started with the conditions marked as done when the
 	// watcher has exited
@@ -111,6 +116,7 @@ func (c *cache) OnListDone() {
 	// nextCache is valid, point the live cache to it
 	c.cache = c.nextCache
 	c.keyCache = c.nextKeyCache
+	c.listDoneAt = time.Now()
 	c.mutex.Unlock()
 
 	log.Debug("Initial list of identities received")
@@ -124,10 +130,15 @@ func (c *cache) OnListDone() {
 func (c *cache) OnUpsert(id idpool.ID, key AllocatorKey) {
 	for _, validator := range c.allocator.cacheValidators {
 		if err := validator(AllocatorChangeUpsert, id, key); err != nil {
-			log.WithError(err).WithFields(logrus.Fields{
+			scopedLog := log.WithError(err).WithFields(logrus.Fields{
 				logfields.Identity: id,
 				logfields.Event:    AllocatorChangeUpsert,
-			}).Warning("Skipping event for invalid identity")
+			})
+			if errors.Is(err, ErrKeyFiltered) {
+				scopedLog.Debug("Skipping event for filtered identity")
+			} else {
+				scopedLog.Warning("Skipping event for invalid identity")
+			}
 			return
 		}
 	}
@@ -154,10 +165,15 @@ func (c *cache) OnUpsert(id idpool.ID, key AllocatorKey) {
 func (c *cache) OnDelete(id idpool.ID, key AllocatorKey) {
 	for _, validator := range c.allocator.cacheValidators {
 		if err := validator(AllocatorChangeDelete, id, key); err != nil {
-			log.WithError(err).WithFields(logrus.Fields{
+			scopedLog := log.WithError(err).WithFields(logrus.Fields{
 				logfields.Identity: id,
 				logfields.Event:    AllocatorChangeDelete,
-			}).Warning("Skipping event for invalid identity")
+			})
+			if errors.Is(err, ErrKeyFiltered) {
+				scopedLog.Debug("Skipping event for filtered identity")
+			} else {
+				scopedLog.Warning("Skipping event for invalid identity")
+			}
 			return
 		}
 	}
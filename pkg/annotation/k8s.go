@@ -37,6 +37,9 @@ const (
 	// CNIPrefix is the common prefix for CNI related annotations.
 	CNIPrefix = "cni.cilium.io"
 
+	// QoSPrefix is the common prefix for Quality of Service related annotations.
+	QoSPrefix = "qos.cilium.io"
+
 	// PodAnnotationMAC is used to store the MAC address of the Pod.
 	PodAnnotationMAC = CNIPrefix + "/mac-address"
 
@@ -105,6 +108,11 @@ const (
 	SharedService      = ServicePrefix + "/shared"
 	SharedServiceAlias = Prefix + "/shared-service"
 
+	// ServicePassiveHealth if set to true, enables passive health scoring
+	// for the backends of the service: backends whose connection failure
+	// rate spikes are quarantined until they recover.
+	ServicePassiveHealth = ServicePrefix + "/passive-health"
+
 	// ServiceAffinity / ServiceAffinityAlias annotations determines the
 	// preferred endpoint destination.
 	// Allowed values:
@@ -117,6 +125,13 @@ const (
 	ServiceAffinity      = ServicePrefix + "/affinity"
 	ServiceAffinityAlias = Prefix + "/service-affinity"
 
+	// ServiceClusterPreference is the annotation listing, in decreasing
+	// priority order, the comma-separated names of the clusters whose
+	// backends should be preferred for a global service. Backends of a
+	// lower-priority cluster are only used when all higher-priority
+	// clusters have no healthy backends, with automatic fail-back.
+	ServiceClusterPreference = ServicePrefix + "/cluster-preference"
+
 	// ProxyVisibility / ProxyVisibilityAlias is the annotation name used to
 	// indicate whether proxy visibility should be enabled for a given pod (i.e.,
 	// all traffic for the pod is redirected to the proxy for the given port /
@@ -152,9 +167,45 @@ const (
 	// which workloads should allocate their IP from
 	IPAMIPv6PoolKey = IPAMPrefix + "/ipv6-pool"
 
+	// IPAMENISubnetIDsKey is the annotation name used on a CiliumNode to
+	// restrict the subnets new ENIs of that node are created in, as a
+	// comma-separated list of subnet IDs
+	IPAMENISubnetIDsKey = IPAMPrefix + "/eni-subnet-ids"
+
+	// IPAMENISubnetTagsKey is the annotation name used on a CiliumNode to
+	// restrict the subnets new ENIs of that node are created in, as a
+	// comma-separated list of key=value subnet tags
+	IPAMENISubnetTagsKey = IPAMPrefix + "/eni-subnet-tags"
+
+	// IPAMPreAllocateKey is the annotation name used on a CiliumNode to
+	// override the number of IP addresses that must be available for
+	// allocation on the node
+	IPAMPreAllocateKey = IPAMPrefix + "/pre-allocate"
+
+	// IPAMMinAllocateKey is the annotation name used on a CiliumNode to
+	// override the minimum number of IP addresses allocated when the node
+	// appears for the first time
+	IPAMMinAllocateKey = IPAMPrefix + "/min-allocate"
+
+	// IPAMMaxAboveWatermarkKey is the annotation name used on a CiliumNode
+	// to override the maximum number of IP addresses allocated beyond the
+	// addresses needed for allocation
+	IPAMMaxAboveWatermarkKey = IPAMPrefix + "/max-above-watermark"
+
+	// IPAMInterruptedKey is the annotation name used on a CiliumNode to
+	// signal that the underlying instance received an interruption notice,
+	// e.g. an EC2 spot interruption, and is about to be terminated. When
+	// set to "true", the operator stops allocating new IP addresses to the
+	// node and releases all unused IPs without the usual release delay.
+	IPAMInterruptedKey = IPAMPrefix + "/interrupted"
+
 	LBIPAMIPsKey     = LBIPAMPrefix + "/ips"
 	LBIPAMIPKeyAlias = Prefix + "/lb-ipam-ips"
 
+	// DSCPEgress is the annotation name used to request a DSCP mark, in the
+	// range of 0 to 63, to be set on all traffic leaving the pod.
+	DSCPEgress = QoSPrefix + "/dscp-egress"
+
 	LBIPAMSharingKey                  = LBIPAMPrefix + "/sharing-key"
 	LBIPAMSharingKeyAlias             = Prefix + "/lb-ipam-sharing-key"
 	LBIPAMSharingAcrossNamespace      = LBIPAMPrefix + "/sharing-cross-namespace"
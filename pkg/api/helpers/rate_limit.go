@@ -31,6 +31,11 @@ func NewAPILimiter(metrics MetricsAPI, rateLimit float64, burst int) *APILimiter
 	}
 }
 
+// SetLimit updates the rate limit of the limiter.
+func (l *APILimiter) SetLimit(rateLimit float64) {
+	l.limiter.SetLimit(rate.Limit(rateLimit))
+}
+
 // Limit applies the rate limiting configuration for the given operation
 func (l *APILimiter) Limit(ctx context.Context, operation string) {
 	r := l.limiter.Reserve()
@@ -14,9 +14,11 @@ import (
 // PrometheusMetrics is an implementation of Prometheus metrics for external
 // API usage
 type PrometheusMetrics struct {
-	registry    metrics.RegisterGatherer
-	APIDuration *prometheus.HistogramVec
-	RateLimit   *prometheus.HistogramVec
+	registry     metrics.RegisterGatherer
+	APIDuration  *prometheus.HistogramVec
+	RateLimit    *prometheus.HistogramVec
+	APICalls     *prometheus.CounterVec
+	CacheLookups *prometheus.CounterVec
 }
 
 // NewPrometheusMetrics returns a new metrics tracking implementation to cover
@@ -38,8 +40,24 @@ func NewPrometheusMetrics(namespace, subsystem string, registry metrics.Register
 		Help:      "Duration of client-side rate limiter blocking",
 	}, []string{"operation"})
 
+	m.APICalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "api_calls_total",
+		Help:      "Number of API calls made, by operation, status and error code",
+	}, []string{"operation", "status", "error_code"})
+
+	m.CacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: subsystem,
+		Name:      "api_cache_lookups_total",
+		Help:      "Number of lookups in the API result cache, by operation and result",
+	}, []string{"operation", "result"})
+
 	registry.MustRegister(m.APIDuration)
 	registry.MustRegister(m.RateLimit)
+	registry.MustRegister(m.APICalls)
+	registry.MustRegister(m.CacheLookups)
 
 	return m
 }
@@ -50,6 +68,23 @@ func (p *PrometheusMetrics) ObserveAPICall(operation, status string, duration fl
 	p.APIDuration.WithLabelValues(operation, status).Observe(duration)
 }
 
+// ObserveAPICallWithErrorCode behaves like ObserveAPICall and additionally
+// counts the call with the error code returned by the API
+func (p *PrometheusMetrics) ObserveAPICallWithErrorCode(operation, status, errorCode string, duration float64) {
+	p.APIDuration.WithLabelValues(operation, status).Observe(duration)
+	p.APICalls.WithLabelValues(operation, status, errorCode).Inc()
+}
+
+// ObserveCacheLookup must be called on every lookup in the API result cache
+// with the operation performed and whether the lookup was a hit
+func (p *PrometheusMetrics) ObserveCacheLookup(operation string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	p.CacheLookups.WithLabelValues(operation, result).Inc()
+}
+
 // ObserveRateLimit must be called in case an API call was subject to rate limiting
 func (p *PrometheusMetrics) ObserveRateLimit(operation string, delay time.Duration) {
 	p.RateLimit.WithLabelValues(operation).Observe(delay.Seconds())
@@ -63,6 +98,17 @@ type NoOpMetrics struct{}
 // implementation will perform no metrics accounting in return.
 func (m *NoOpMetrics) ObserveAPICall(call, status string, duration float64) {}
 
+// ObserveAPICallWithErrorCode behaves like ObserveAPICall and additionally
+// receives the error code returned by the API. This No-op implementation will
+// perform no metrics accounting in return.
+func (m *NoOpMetrics) ObserveAPICallWithErrorCode(operation, status, errorCode string, duration float64) {
+}
+
+// ObserveCacheLookup must be called on every lookup in the API result cache
+// with the operation performed and whether the lookup was a hit. This No-op
+// implementation will perform no metrics accounting in return.
+func (m *NoOpMetrics) ObserveCacheLookup(operation string, hit bool) {}
+
 // ObserveRateLimit must be called in case an API call was subject to rate
 // limiting. This No-op implementation will perform no metrics accounting in
 // return.
@@ -12,16 +12,20 @@ import (
 
 // MockMetrics is a mock implementation of pkg/api/metrics
 type MockMetrics struct {
-	mutex     lock.RWMutex
-	apiCall   map[string]float64
-	rateLimit map[string]time.Duration
+	mutex        lock.RWMutex
+	apiCall      map[string]float64
+	apiCallCount map[string]int64
+	cacheLookups map[string]int64
+	rateLimit    map[string]time.Duration
 }
 
 // NewMockMetrics returns a new metrics implementation with a mocked backend
 func NewMockMetrics() *MockMetrics {
 	return &MockMetrics{
-		apiCall:   map[string]float64{},
-		rateLimit: map[string]time.Duration{},
+		apiCall:      map[string]float64{},
+		apiCallCount: map[string]int64{},
+		cacheLookups: map[string]int64{},
+		rateLimit:    map[string]time.Duration{},
 	}
 }
 
@@ -43,6 +47,41 @@ func (m *MockMetrics) ObserveAPICall(operation, status string, duration float64)
 	m.mutex.Unlock()
 }
 
+// APICallCount returns the number of API calls for a given operation, status
+// and error code
+func (m *MockMetrics) APICallCount(operation, status, errorCode string) int64 {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.apiCallCount[fmt.Sprintf("operation=%s, status=%s, errorCode=%s", operation, status, errorCode)]
+}
+
+// ObserveAPICallWithErrorCode behaves like ObserveAPICall and additionally
+// counts the call with the error code returned by the API. The total can be
+// retrieved with APICallCount().
+func (m *MockMetrics) ObserveAPICallWithErrorCode(operation, status, errorCode string, duration float64) {
+	m.mutex.Lock()
+	m.apiCall[fmt.Sprintf("operation=%s, status=%s", operation, status)] += duration
+	m.apiCallCount[fmt.Sprintf("operation=%s, status=%s, errorCode=%s", operation, status, errorCode)]++
+	m.mutex.Unlock()
+}
+
+// CacheLookupCount returns the number of cache lookups for a given operation
+// and result
+func (m *MockMetrics) CacheLookupCount(operation string, hit bool) int64 {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.cacheLookups[fmt.Sprintf("operation=%s, hit=%t", operation, hit)]
+}
+
+// ObserveCacheLookup must be called on every lookup in the API result cache
+// with the operation performed and whether the lookup was a hit. The total
+// can be retrieved with CacheLookupCount().
+func (m *MockMetrics) ObserveCacheLookup(operation string, hit bool) {
+	m.mutex.Lock()
+	m.cacheLookups[fmt.Sprintf("operation=%s, hit=%t", operation, hit)]++
+	m.mutex.Unlock()
+}
+
 // RateLimit returns the sum of all rate limited durations of all API for a
 // given operation
 func (m *MockMetrics) RateLimit(operation string) time.Duration {
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ec2
+
+import (
+	"time"
+
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// describeCache caches the results of EC2 describe API calls for a limited
+// amount of time. It avoids re-describing all resources of large clusters on
+// every resync interval, at the cost of the results being up to the TTL
+// stale.
+type describeCache struct {
+	mutex   lock.RWMutex
+	ttl     time.Duration
+	entries map[string]describeCacheEntry
+}
+
+type describeCacheEntry struct {
+	result  any
+	expires time.Time
+}
+
+// newDescribeCache returns a new cache for describe API call results with the
+// given TTL. nil is returned if the TTL is zero, disabling caching.
+func newDescribeCache(ttl time.Duration) *describeCache {
+	if ttl == 0 {
+		return nil
+	}
+	return &describeCache{
+		ttl:     ttl,
+		entries: map[string]describeCacheEntry{},
+	}
+}
+
+// get returns the cached result of the given operation if it has not expired
+// yet
+func (c *describeCache) get(operation string) (any, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	entry, ok := c.entries[operation]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.result, true
+}
+
+// put caches the result of the given operation for the configured TTL
+func (c *describeCache) put(operation string, result any) {
+	c.mutex.Lock()
+	c.entries[operation] = describeCacheEntry{
+		result:  result,
+		expires: time.Now().Add(c.ttl),
+	}
+	c.mutex.Unlock()
+}
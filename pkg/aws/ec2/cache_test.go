@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeCache(t *testing.T) {
+	// A TTL of zero disables caching
+	require.Nil(t, newDescribeCache(0))
+
+	cache := newDescribeCache(time.Minute)
+	_, ok := cache.get("DescribeSubnets")
+	require.False(t, ok)
+
+	cache.put("DescribeSubnets", []string{"s-1"})
+	result, ok := cache.get("DescribeSubnets")
+	require.True(t, ok)
+	require.Equal(t, []string{"s-1"}, result)
+
+	// Expired entries are not returned
+	cache.entries["DescribeSubnets"] = describeCacheEntry{
+		result:  []string{"s-1"},
+		expires: time.Now().Add(-time.Second),
+	}
+	_, ok = cache.get("DescribeSubnets")
+	require.False(t, ok)
+}
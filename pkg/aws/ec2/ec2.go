@@ -7,13 +7,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2_types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/cilium/cilium/pkg/api/helpers"
@@ -42,7 +46,8 @@ const (
 // Client represents an EC2 API client
 type Client struct {
 	ec2Client           *ec2.Client
-	limiter             *helpers.APILimiter
+	limiter             *apiLimiter
+	cache               *describeCache
 	metricsAPI          MetricsAPI
 	subnetsFilters      []ec2_types.Filter
 	instancesFilters    []ec2_types.Filter
@@ -54,10 +59,12 @@ type Client struct {
 type MetricsAPI interface {
 	helpers.MetricsAPI
 	ObserveAPICall(call, status string, duration float64)
+	ObserveAPICallWithErrorCode(operation, status, errorCode string, duration float64)
+	ObserveCacheLookup(operation string, hit bool)
 }
 
 // NewClient returns a new EC2 client
-func NewClient(ec2Client *ec2.Client, metrics MetricsAPI, rateLimit float64, burst int, subnetsFilters, instancesFilters []ec2_types.Filter, eniTags map[string]string, usePrimary bool) *Client {
+func NewClient(ec2Client *ec2.Client, metrics MetricsAPI, rateLimit float64, burst int, cacheTTL time.Duration, subnetsFilters, instancesFilters []ec2_types.Filter, eniTags map[string]string, usePrimary bool) *Client {
 	eniTagSpecification := ec2_types.TagSpecification{
 		ResourceType: ec2_types.ResourceTypeNetworkInterface,
 		Tags:         createAWSTagSlice(eniTags),
@@ -66,7 +73,8 @@ func NewClient(ec2Client *ec2.Client, metrics MetricsAPI, rateLimit float64, bur
 	return &Client{
 		ec2Client:           ec2Client,
 		metricsAPI:          metrics,
-		limiter:             helpers.NewAPILimiter(metrics, rateLimit, burst),
+		limiter:             newAPILimiter(metrics, rateLimit, burst),
+		cache:               newDescribeCache(cacheTTL),
 		subnetsFilters:      subnetsFilters,
 		instancesFilters:    instancesFilters,
 		eniTagSpecification: eniTagSpecification,
@@ -74,6 +82,25 @@ func NewClient(ec2Client *ec2.Client, metrics MetricsAPI, rateLimit float64, bur
 	}
 }
 
+// cacheGet returns the cached result of the given describe operation if
+// caching is enabled and the result has not expired yet
+func (c *Client) cacheGet(operation string) (any, bool) {
+	if c.cache == nil {
+		return nil, false
+	}
+	result, ok := c.cache.get(operation)
+	c.metricsAPI.ObserveCacheLookup(operation, ok)
+	return result, ok
+}
+
+// cachePut caches the result of the given describe operation if caching is
+// enabled
+func (c *Client) cachePut(operation string, result any) {
+	if c.cache != nil {
+		c.cache.put(operation, result)
+	}
+}
+
 // NewConfig returns a new aws.Config configured with the correct region + endpoint resolver
 func NewConfig(ctx context.Context) (aws.Config, error) {
 	cfg, err := awsconfig.LoadDefaultConfig(ctx)
@@ -81,18 +108,32 @@ func NewConfig(ctx context.Context) (aws.Config, error) {
 		return aws.Config{}, fmt.Errorf("unable to load AWS configuration: %w", err)
 	}
 
-	metadataClient := imds.NewFromConfig(cfg)
-	instance, err := metadataClient.GetInstanceIdentityDocument(ctx, &imds.GetInstanceIdentityDocumentInput{})
-	if err != nil {
-		return aws.Config{}, fmt.Errorf("unable to retrieve instance identity document: %w", err)
+	// Only fall back to the instance identity document if the region is not
+	// already known through the environment or shared configuration. This
+	// allows running in hardened environments which block access to IMDS.
+	if cfg.Region == "" {
+		metadataClient := imds.NewFromConfig(cfg)
+		instance, err := metadataClient.GetInstanceIdentityDocument(ctx, &imds.GetInstanceIdentityDocumentInput{})
+		if err != nil {
+			return aws.Config{}, fmt.Errorf("unable to retrieve instance identity document: %w", err)
+		}
+		cfg.Region = instance.Region
 	}
 
-	cfg.Region = instance.Region
 	cfg.EndpointResolver = aws.EndpointResolverFunc(endpoints.Resolver)
 
 	return cfg, nil
 }
 
+// AssumeRole returns a copy of cfg with credentials which assume the given
+// IAM role, e.g. to manage ENIs and subnets owned by a different account in
+// shared VPC setups. The credentials are cached and automatically refreshed
+// before they expire.
+func AssumeRole(cfg aws.Config, roleARN string) aws.Config {
+	cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), roleARN))
+	return cfg
+}
+
 // NewSubnetsFilters transforms a map of tags and values and a slice of subnets
 // into a slice of ec2.Filter adequate to filter AWS subnets.
 func NewSubnetsFilters(tags map[string]string, ids []string) []ec2_types.Filter {
@@ -146,6 +187,10 @@ func MergeTags(tagMaps ...map[string]string) map[string]string {
 // the AWS API server. If no specific status is provided, either "OK" or
 // "Failed" is returned based on the error variable.
 func deriveStatus(err error) string {
+	if isRequestLimitExceeded(err) {
+		return "Throttled"
+	}
+
 	var respErr *awshttp.ResponseError
 	if errors.As(err, &respErr) {
 		return respErr.Response.Status
@@ -158,6 +203,22 @@ func deriveStatus(err error) string {
 	return "OK"
 }
 
+// deriveErrorCode returns the error code of the error returned by the AWS API
+// server, an empty string for successful calls, or "Unknown" for failures
+// without an error code.
+func deriveErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+
+	return "Unknown"
+}
+
 func DetectEKSClusterName(ctx context.Context, cfg aws.Config) (string, error) {
 	instance, err := imds.NewFromConfig(cfg).GetInstanceIdentityDocument(ctx, &imds.GetInstanceIdentityDocumentInput{})
 	if err != nil {
@@ -199,7 +260,8 @@ func (c *Client) GetDetachedNetworkInterfaces(ctx context.Context, tags ipamType
 		c.limiter.Limit(ctx, "DescribeNetworkInterfaces")
 		sinceStart := spanstat.Start()
 		output, err := paginator.NextPage(ctx)
-		c.metricsAPI.ObserveAPICall("DescribeNetworkInterfaces", deriveStatus(err), sinceStart.Seconds())
+		c.metricsAPI.ObserveAPICallWithErrorCode("DescribeNetworkInterfaces", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+		c.limiter.Observe("DescribeNetworkInterfaces", err)
 		if err != nil {
 			return nil, err
 		}
@@ -215,6 +277,10 @@ func (c *Client) GetDetachedNetworkInterfaces(ctx context.Context, tags ipamType
 
 // describeNetworkInterfaces lists all ENIs
 func (c *Client) describeNetworkInterfaces(ctx context.Context, subnets ipamTypes.SubnetMap) ([]ec2_types.NetworkInterface, error) {
+	if cached, ok := c.cacheGet("DescribeNetworkInterfaces"); ok {
+		return cached.([]ec2_types.NetworkInterface), nil
+	}
+
 	var result []ec2_types.NetworkInterface
 	input := &ec2.DescribeNetworkInterfacesInput{
 		// Filters out ipv6-only ENIs. For now we require that every interface
@@ -241,12 +307,14 @@ func (c *Client) describeNetworkInterfaces(ctx context.Context, subnets ipamType
 		c.limiter.Limit(ctx, "DescribeNetworkInterfaces")
 		sinceStart := spanstat.Start()
 		output, err := paginator.NextPage(ctx)
-		c.metricsAPI.ObserveAPICall("DescribeNetworkInterfaces", deriveStatus(err), sinceStart.Seconds())
+		c.metricsAPI.ObserveAPICallWithErrorCode("DescribeNetworkInterfaces", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+		c.limiter.Observe("DescribeNetworkInterfaces", err)
 		if err != nil {
 			return nil, err
 		}
 		result = append(result, output.NetworkInterfaces...)
 	}
+	c.cachePut("DescribeNetworkInterfaces", result)
 	return result, nil
 }
 
@@ -267,7 +335,8 @@ func (c *Client) describeNetworkInterfacesByInstance(ctx context.Context, instan
 		c.limiter.Limit(ctx, "DescribeNetworkInterfaces")
 		sinceStart := spanstat.Start()
 		output, err := paginator.NextPage(ctx)
-		c.metricsAPI.ObserveAPICall("DescribeNetworkInterfaces", deriveStatus(err), sinceStart.Seconds())
+		c.metricsAPI.ObserveAPICallWithErrorCode("DescribeNetworkInterfaces", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+		c.limiter.Observe("DescribeNetworkInterfaces", err)
 		if err != nil {
 			return nil, err
 		}
@@ -278,6 +347,10 @@ func (c *Client) describeNetworkInterfacesByInstance(ctx context.Context, instan
 
 // describeNetworkInterfacesFromInstances lists all ENIs matching filtered EC2 instances
 func (c *Client) describeNetworkInterfacesFromInstances(ctx context.Context) ([]ec2_types.NetworkInterface, error) {
+	if cached, ok := c.cacheGet("DescribeNetworkInterfacesFromInstances"); ok {
+		return cached.([]ec2_types.NetworkInterface), nil
+	}
+
 	enisFromInstances := make(map[string]struct{})
 
 	instanceAttrs := &ec2.DescribeInstancesInput{}
@@ -290,7 +363,8 @@ func (c *Client) describeNetworkInterfacesFromInstances(ctx context.Context) ([]
 		c.limiter.Limit(ctx, "DescribeInstances")
 		sinceStart := spanstat.Start()
 		output, err := paginator.NextPage(ctx)
-		c.metricsAPI.ObserveAPICall("DescribeInstances", deriveStatus(err), sinceStart.Seconds())
+		c.metricsAPI.ObserveAPICallWithErrorCode("DescribeInstances", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+		c.limiter.Observe("DescribeInstances", err)
 		if err != nil {
 			return nil, err
 		}
@@ -331,12 +405,14 @@ func (c *Client) describeNetworkInterfacesFromInstances(ctx context.Context) ([]
 		c.limiter.Limit(ctx, "DescribeNetworkInterfaces")
 		sinceStart := spanstat.Start()
 		output, err := ENIPaginator.NextPage(ctx)
-		c.metricsAPI.ObserveAPICall("DescribeNetworkInterfaces", deriveStatus(err), sinceStart.Seconds())
+		c.metricsAPI.ObserveAPICallWithErrorCode("DescribeNetworkInterfaces", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+		c.limiter.Observe("DescribeNetworkInterfaces", err)
 		if err != nil {
 			return nil, err
 		}
 		result = append(result, output.NetworkInterfaces...)
 	}
+	c.cachePut("DescribeNetworkInterfacesFromInstances", result)
 	return result, nil
 }
 
@@ -414,6 +490,18 @@ func parseENI(iface *ec2_types.NetworkInterface, vpcs ipamTypes.VirtualNetworkMa
 		eni.Prefixes = append(eni.Prefixes, aws.ToString(prefix.Ipv4Prefix))
 	}
 
+	for _, ip := range iface.Ipv6Addresses {
+		if ip.Ipv6Address != nil {
+			eni.IPv6Addresses = append(eni.IPv6Addresses, aws.ToString(ip.Ipv6Address))
+		}
+	}
+
+	for _, prefix := range iface.Ipv6Prefixes {
+		if prefix.Ipv6Prefix != nil {
+			eni.IPv6Prefixes = append(eni.IPv6Prefixes, aws.ToString(prefix.Ipv6Prefix))
+		}
+	}
+
 	for _, g := range iface.Groups {
 		if g.GroupId != nil {
 			eni.SecurityGroups = append(eni.SecurityGroups, aws.ToString(g.GroupId))
@@ -488,18 +576,24 @@ func (c *Client) GetInstances(ctx context.Context, vpcs ipamTypes.VirtualNetwork
 
 // describeVpcs lists all VPCs
 func (c *Client) describeVpcs(ctx context.Context) ([]ec2_types.Vpc, error) {
+	if cached, ok := c.cacheGet("DescribeVpcs"); ok {
+		return cached.([]ec2_types.Vpc), nil
+	}
+
 	var result []ec2_types.Vpc
 	paginator := ec2.NewDescribeVpcsPaginator(c.ec2Client, &ec2.DescribeVpcsInput{})
 	for paginator.HasMorePages() {
 		c.limiter.Limit(ctx, "DescribeVpcs")
 		sinceStart := spanstat.Start()
 		output, err := paginator.NextPage(ctx)
-		c.metricsAPI.ObserveAPICall("DescribeVpcs", deriveStatus(err), sinceStart.Seconds())
+		c.metricsAPI.ObserveAPICallWithErrorCode("DescribeVpcs", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+		c.limiter.Observe("DescribeVpcs", err)
 		if err != nil {
 			return nil, err
 		}
 		result = append(result, output.Vpcs...)
 	}
+	c.cachePut("DescribeVpcs", result)
 	return result, nil
 }
 
@@ -533,6 +627,10 @@ func (c *Client) GetVpcs(ctx context.Context) (ipamTypes.VirtualNetworkMap, erro
 
 // describeSubnets lists all subnets
 func (c *Client) describeSubnets(ctx context.Context) ([]ec2_types.Subnet, error) {
+	if cached, ok := c.cacheGet("DescribeSubnets"); ok {
+		return cached.([]ec2_types.Subnet), nil
+	}
+
 	var result []ec2_types.Subnet
 	input := &ec2.DescribeSubnetsInput{}
 	if len(c.subnetsFilters) > 0 {
@@ -543,13 +641,15 @@ func (c *Client) describeSubnets(ctx context.Context) ([]ec2_types.Subnet, error
 		c.limiter.Limit(ctx, "DescribeSubnets")
 		sinceStart := spanstat.Start()
 		output, err := paginator.NextPage(ctx)
-		c.metricsAPI.ObserveAPICall("DescribeSubnets", deriveStatus(err), sinceStart.Seconds())
+		c.metricsAPI.ObserveAPICallWithErrorCode("DescribeSubnets", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+		c.limiter.Observe("DescribeSubnets", err)
 		if err != nil {
 			return nil, err
 		}
 		result = append(result, output.Subnets...)
 
 	}
+	c.cachePut("DescribeSubnets", result)
 	return result, nil
 }
 
@@ -620,7 +720,8 @@ func (c *Client) CreateNetworkInterface(ctx context.Context, toAllocate int32, s
 	c.limiter.Limit(ctx, "CreateNetworkInterface")
 	sinceStart := spanstat.Start()
 	output, err := c.ec2Client.CreateNetworkInterface(ctx, input)
-	c.metricsAPI.ObserveAPICall("CreateNetworkInterface", deriveStatus(err), sinceStart.Seconds())
+	c.metricsAPI.ObserveAPICallWithErrorCode("CreateNetworkInterface", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+	c.limiter.Observe("CreateNetworkInterface", err)
 	if err != nil {
 		return "", nil, err
 	}
@@ -638,6 +739,73 @@ func (c *Client) CreateNetworkInterface(ctx context.Context, toAllocate int32, s
 	return eni.ID, eni, nil
 }
 
+// AssignIpv6Addresses assigns the specified number of IPv6 addresses to the
+// ENI, for IPv6-native and dual-stack nodes.
+func (c *Client) AssignIpv6Addresses(ctx context.Context, eniID string, addresses int32) error {
+	input := &ec2.AssignIpv6AddressesInput{
+		NetworkInterfaceId: aws.String(eniID),
+		Ipv6AddressCount:   aws.Int32(addresses),
+	}
+
+	c.limiter.Limit(ctx, "AssignIpv6Addresses")
+	sinceStart := spanstat.Start()
+	_, err := c.ec2Client.AssignIpv6Addresses(ctx, input)
+	c.metricsAPI.ObserveAPICallWithErrorCode("AssignIpv6Addresses", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+	c.limiter.Observe("AssignIpv6Addresses", err)
+	return err
+}
+
+// UnassignIpv6Addresses unassigns the specified IPv6 addresses from the ENI.
+func (c *Client) UnassignIpv6Addresses(ctx context.Context, eniID string, addresses []string) error {
+	input := &ec2.UnassignIpv6AddressesInput{
+		NetworkInterfaceId: aws.String(eniID),
+		Ipv6Addresses:      addresses,
+	}
+
+	c.limiter.Limit(ctx, "UnassignIpv6Addresses")
+	sinceStart := spanstat.Start()
+	_, err := c.ec2Client.UnassignIpv6Addresses(ctx, input)
+	c.metricsAPI.ObserveAPICallWithErrorCode("UnassignIpv6Addresses", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+	c.limiter.Observe("UnassignIpv6Addresses", err)
+	return err
+}
+
+// AssociateTrunkInterface associates the given branch ENI with the trunk ENI
+// on the specified VLAN and returns the association ID.
+func (c *Client) AssociateTrunkInterface(ctx context.Context, trunkENI, branchENI string, vlanID int32) (string, error) {
+	input := &ec2.AssociateTrunkInterfaceInput{
+		TrunkInterfaceId:  aws.String(trunkENI),
+		BranchInterfaceId: aws.String(branchENI),
+		VlanId:            aws.Int32(vlanID),
+	}
+
+	c.limiter.Limit(ctx, "AssociateTrunkInterface")
+	sinceStart := spanstat.Start()
+	output, err := c.ec2Client.AssociateTrunkInterface(ctx, input)
+	c.metricsAPI.ObserveAPICallWithErrorCode("AssociateTrunkInterface", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+	c.limiter.Observe("AssociateTrunkInterface", err)
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.InterfaceAssociation.AssociationId), nil
+}
+
+// DisassociateTrunkInterface removes the association between a branch ENI and
+// its trunk ENI.
+func (c *Client) DisassociateTrunkInterface(ctx context.Context, associationID string) error {
+	input := &ec2.DisassociateTrunkInterfaceInput{
+		AssociationId: aws.String(associationID),
+	}
+
+	c.limiter.Limit(ctx, "DisassociateTrunkInterface")
+	sinceStart := spanstat.Start()
+	_, err := c.ec2Client.DisassociateTrunkInterface(ctx, input)
+	c.metricsAPI.ObserveAPICallWithErrorCode("DisassociateTrunkInterface", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+	c.limiter.Observe("DisassociateTrunkInterface", err)
+	return err
+}
+
 // DeleteNetworkInterface deletes an ENI with the specified ID
 func (c *Client) DeleteNetworkInterface(ctx context.Context, eniID string) error {
 	input := &ec2.DeleteNetworkInterfaceInput{
@@ -647,7 +815,8 @@ func (c *Client) DeleteNetworkInterface(ctx context.Context, eniID string) error
 	c.limiter.Limit(ctx, "DeleteNetworkInterface")
 	sinceStart := spanstat.Start()
 	_, err := c.ec2Client.DeleteNetworkInterface(ctx, input)
-	c.metricsAPI.ObserveAPICall("DeleteNetworkInterface", deriveStatus(err), sinceStart.Seconds())
+	c.metricsAPI.ObserveAPICallWithErrorCode("DeleteNetworkInterface", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+	c.limiter.Observe("DeleteNetworkInterface", err)
 	return err
 }
 
@@ -662,7 +831,8 @@ func (c *Client) AttachNetworkInterface(ctx context.Context, index int32, instan
 	c.limiter.Limit(ctx, "AttachNetworkInterface")
 	sinceStart := spanstat.Start()
 	output, err := c.ec2Client.AttachNetworkInterface(ctx, input)
-	c.metricsAPI.ObserveAPICall("AttachNetworkInterface", deriveStatus(err), sinceStart.Seconds())
+	c.metricsAPI.ObserveAPICallWithErrorCode("AttachNetworkInterface", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+	c.limiter.Observe("AttachNetworkInterface", err)
 	if err != nil {
 		return "", err
 	}
@@ -685,7 +855,8 @@ func (c *Client) ModifyNetworkInterface(ctx context.Context, eniID, attachmentID
 	c.limiter.Limit(ctx, "ModifyNetworkInterfaceAttribute")
 	sinceStart := spanstat.Start()
 	_, err := c.ec2Client.ModifyNetworkInterfaceAttribute(ctx, input)
-	c.metricsAPI.ObserveAPICall("ModifyNetworkInterface", deriveStatus(err), sinceStart.Seconds())
+	c.metricsAPI.ObserveAPICallWithErrorCode("ModifyNetworkInterface", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+	c.limiter.Observe("ModifyNetworkInterface", err)
 	return err
 }
 
@@ -700,7 +871,8 @@ func (c *Client) AssignPrivateIpAddresses(ctx context.Context, eniID string, add
 	c.limiter.Limit(ctx, "AssignPrivateIpAddresses")
 	sinceStart := spanstat.Start()
 	_, err := c.ec2Client.AssignPrivateIpAddresses(ctx, input)
-	c.metricsAPI.ObserveAPICall("AssignPrivateIpAddresses", deriveStatus(err), sinceStart.Seconds())
+	c.metricsAPI.ObserveAPICallWithErrorCode("AssignPrivateIpAddresses", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+	c.limiter.Observe("AssignPrivateIpAddresses", err)
 	return err
 }
 
@@ -714,7 +886,8 @@ func (c *Client) UnassignPrivateIpAddresses(ctx context.Context, eniID string, a
 	c.limiter.Limit(ctx, "UnassignPrivateIpAddresses")
 	sinceStart := spanstat.Start()
 	_, err := c.ec2Client.UnassignPrivateIpAddresses(ctx, input)
-	c.metricsAPI.ObserveAPICall("UnassignPrivateIpAddresses", deriveStatus(err), sinceStart.Seconds())
+	c.metricsAPI.ObserveAPICallWithErrorCode("UnassignPrivateIpAddresses", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+	c.limiter.Observe("UnassignPrivateIpAddresses", err)
 	return err
 }
 
@@ -727,7 +900,8 @@ func (c *Client) AssignENIPrefixes(ctx context.Context, eniID string, prefixes i
 	c.limiter.Limit(ctx, "AssignPrivateIpAddresses")
 	sinceStart := spanstat.Start()
 	_, err := c.ec2Client.AssignPrivateIpAddresses(ctx, input)
-	c.metricsAPI.ObserveAPICall("AssignPrivateIpAddresses", deriveStatus(err), sinceStart.Seconds())
+	c.metricsAPI.ObserveAPICallWithErrorCode("AssignPrivateIpAddresses", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+	c.limiter.Observe("AssignPrivateIpAddresses", err)
 	return err
 }
 
@@ -740,7 +914,8 @@ func (c *Client) UnassignENIPrefixes(ctx context.Context, eniID string, prefixes
 	c.limiter.Limit(ctx, "UnassignPrivateIpAddresses")
 	sinceStart := spanstat.Start()
 	_, err := c.ec2Client.UnassignPrivateIpAddresses(ctx, input)
-	c.metricsAPI.ObserveAPICall("UnassignPrivateIpAddresses", deriveStatus(err), sinceStart.Seconds())
+	c.metricsAPI.ObserveAPICallWithErrorCode("UnassignPrivateIpAddresses", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+	c.limiter.Observe("UnassignPrivateIpAddresses", err)
 	return err
 }
 
@@ -758,18 +933,24 @@ func createAWSTagSlice(tags map[string]string) []ec2_types.Tag {
 }
 
 func (c *Client) describeSecurityGroups(ctx context.Context) ([]ec2_types.SecurityGroup, error) {
+	if cached, ok := c.cacheGet("DescribeSecurityGroups"); ok {
+		return cached.([]ec2_types.SecurityGroup), nil
+	}
+
 	var result []ec2_types.SecurityGroup
 	paginator := ec2.NewDescribeSecurityGroupsPaginator(c.ec2Client, &ec2.DescribeSecurityGroupsInput{})
 	for paginator.HasMorePages() {
 		c.limiter.Limit(ctx, "DescribeSecurityGroups")
 		sinceStart := spanstat.Start()
 		output, err := paginator.NextPage(ctx)
-		c.metricsAPI.ObserveAPICall("DescribeSecurityGroups", deriveStatus(err), sinceStart.Seconds())
+		c.metricsAPI.ObserveAPICallWithErrorCode("DescribeSecurityGroups", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+		c.limiter.Observe("DescribeSecurityGroups", err)
 		if err != nil {
 			return nil, err
 		}
 		result = append(result, output.SecurityGroups...)
 	}
+	c.cachePut("DescribeSecurityGroups", result)
 	return result, nil
 }
 
@@ -810,7 +991,8 @@ func (c *Client) GetInstanceTypes(ctx context.Context) ([]ec2_types.InstanceType
 		c.limiter.Limit(ctx, "DescribeInstanceTypes")
 		sinceStart := spanstat.Start()
 		output, err := paginator.NextPage(ctx)
-		c.metricsAPI.ObserveAPICall("DescribeInstanceTypes", deriveStatus(err), sinceStart.Seconds())
+		c.metricsAPI.ObserveAPICallWithErrorCode("DescribeInstanceTypes", deriveStatus(err), deriveErrorCode(err), sinceStart.Seconds())
+		c.limiter.Observe("DescribeInstanceTypes", err)
 		if err != nil {
 			return nil, err
 		}
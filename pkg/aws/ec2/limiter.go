@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/aws/smithy-go"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/pkg/api/helpers"
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+const (
+	// RequestLimitExceededStr is the AWS error code returned when API
+	// calls are throttled
+	RequestLimitExceededStr = "RequestLimitExceeded"
+
+	// throttleBackoffFactor is applied to the current rate limit upon a
+	// throttling response
+	throttleBackoffFactor = 0.5
+
+	// throttleRecoveryFactor is applied to the current rate limit upon a
+	// successful operation, to gradually recover towards the configured one
+	throttleRecoveryFactor = 1.25
+
+	// throttleRecoveryInterval is the minimum amount of time between two
+	// subsequent rate limit adjustments
+	throttleRecoveryInterval = time.Minute
+
+	// minLimitFraction is the fraction of the configured rate limit the
+	// adaptation never backs off below
+	minLimitFraction = 1.0 / 8
+)
+
+// apiLimiter rate limits EC2 API calls through two separate token buckets, so
+// that bursts of describe operations cannot starve the attach and allocate
+// operations resolving IP deficits: mutating operations may consume the full
+// configured rate, while describe operations are capped at half of it. The
+// rates additionally adapt to RequestLimitExceeded responses returned by the
+// EC2 API, halving upon throttling and gradually recovering towards the
+// configured limit while operations succeed.
+type apiLimiter struct {
+	// mutating rate limits the operations mutating state
+	mutating *helpers.APILimiter
+
+	// describes rate limits the read-only describe operations
+	describes *helpers.APILimiter
+
+	mutex      lock.Mutex
+	baseLimit  float64
+	curLimit   float64
+	lastAdjust time.Time
+}
+
+func newAPILimiter(metrics helpers.MetricsAPI, rateLimit float64, burst int) *apiLimiter {
+	return &apiLimiter{
+		mutating:  helpers.NewAPILimiter(metrics, rateLimit, burst),
+		describes: helpers.NewAPILimiter(metrics, rateLimit/2, burst),
+		baseLimit: rateLimit,
+		curLimit:  rateLimit,
+	}
+}
+
+// isDescribeOperation returns true for read-only operations.
+func isDescribeOperation(operation string) bool {
+	return strings.HasPrefix(operation, "Describe")
+}
+
+// Limit applies the rate limiting configuration for the given operation.
+func (l *apiLimiter) Limit(ctx context.Context, operation string) {
+	if isDescribeOperation(operation) {
+		l.describes.Limit(ctx, operation)
+		return
+	}
+	l.mutating.Limit(ctx, operation)
+}
+
+// Observe adapts the rate limits based on the outcome of the given operation.
+func (l *apiLimiter) Observe(operation string, err error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	switch {
+	case isRequestLimitExceeded(err):
+		l.curLimit = math.Max(l.curLimit*throttleBackoffFactor, l.baseLimit*minLimitFraction)
+		log.WithField("operation", operation).WithField("rateLimit", l.curLimit).
+			Warning("Throttled by the EC2 API, backing off the request rate")
+	case err == nil && l.curLimit < l.baseLimit && time.Since(l.lastAdjust) >= throttleRecoveryInterval:
+		l.curLimit = math.Min(l.curLimit*throttleRecoveryFactor, l.baseLimit)
+	default:
+		return
+	}
+
+	l.lastAdjust = time.Now()
+	l.mutating.SetLimit(l.curLimit)
+	l.describes.SetLimit(l.curLimit / 2)
+}
+
+// isRequestLimitExceeded returns true if the given error is a throttling
+// response from the EC2 API.
+func isRequestLimitExceeded(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == RequestLimitExceededStr
+}
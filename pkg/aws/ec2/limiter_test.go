@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ec2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/smithy-go"
+
+	"github.com/cilium/cilium/pkg/api/metrics/mock"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+func TestAPILimiterAdaptation(t *testing.T) {
+	limiter := newAPILimiter(mock.NewMockMetrics(), 16.0, 4)
+	throttled := &smithy.GenericAPIError{Code: RequestLimitExceededStr}
+
+	if !isRequestLimitExceeded(throttled) {
+		t.Fatal("expected RequestLimitExceeded error to be detected")
+	}
+	if isRequestLimitExceeded(nil) || isRequestLimitExceeded(errors.New("other")) {
+		t.Fatal("unexpected throttling detection")
+	}
+
+	// Each throttling response halves the current limit
+	limiter.Observe("CreateNetworkInterface", throttled)
+	if limiter.curLimit != 8.0 {
+		t.Fatalf("expected limit 8.0 after throttling, got %f", limiter.curLimit)
+	}
+	limiter.Observe("DescribeSubnets", throttled)
+	if limiter.curLimit != 4.0 {
+		t.Fatalf("expected limit 4.0 after throttling, got %f", limiter.curLimit)
+	}
+
+	// The limit never backs off below a fraction of the configured one
+	for i := 0; i < 10; i++ {
+		limiter.Observe("CreateNetworkInterface", throttled)
+	}
+	if limiter.curLimit != 2.0 {
+		t.Fatalf("expected limit floor 2.0, got %f", limiter.curLimit)
+	}
+
+	// Successful operations do not recover the limit before the cool-down
+	limiter.Observe("CreateNetworkInterface", nil)
+	if limiter.curLimit != 2.0 {
+		t.Fatalf("expected limit to remain 2.0 within the cool-down, got %f", limiter.curLimit)
+	}
+
+	// Once the cool-down has elapsed, the limit gradually recovers
+	limiter.lastAdjust = time.Now().Add(-2 * throttleRecoveryInterval)
+	limiter.Observe("CreateNetworkInterface", nil)
+	if limiter.curLimit != 2.5 {
+		t.Fatalf("expected limit 2.5 after recovery, got %f", limiter.curLimit)
+	}
+
+	// Recovery is capped at the configured limit
+	limiter.curLimit = 15.0
+	limiter.lastAdjust = time.Now().Add(-2 * throttleRecoveryInterval)
+	limiter.Observe("CreateNetworkInterface", nil)
+	if limiter.curLimit != 16.0 {
+		t.Fatalf("expected limit capped at 16.0, got %f", limiter.curLimit)
+	}
+}
+
+func TestIsDescribeOperation(t *testing.T) {
+	if !isDescribeOperation("DescribeNetworkInterfaces") {
+		t.Fatal("expected DescribeNetworkInterfaces to be a describe operation")
+	}
+	if isDescribeOperation("AssignPrivateIpAddresses") {
+		t.Fatal("expected AssignPrivateIpAddresses to be a mutating operation")
+	}
+}
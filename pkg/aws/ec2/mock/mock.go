@@ -42,9 +42,18 @@ const (
 	AssignPrivateIpAddresses
 	UnassignPrivateIpAddresses
 	TagENI
+	AssociateTrunkInterface
+	DisassociateTrunkInterface
 	MaxOperation
 )
 
+// trunkAssociation represents the association of a branch ENI with a trunk ENI
+type trunkAssociation struct {
+	trunkENI  string
+	branchENI string
+	vlanID    int32
+}
+
 // API represents a mocked EC2 API
 type API struct {
 	mutex          lock.RWMutex
@@ -60,6 +69,10 @@ type API struct {
 	limiter        *rate.Limiter
 	delaySim       *helpers.DelaySimulator
 	pdSubnet       *net.IPNet
+
+	// trunkAssociations are the branch to trunk ENI associations indexed
+	// by association ID
+	trunkAssociations map[string]trunkAssociation
 }
 
 // NewAPI returns a new mocked EC2 API
@@ -92,6 +105,8 @@ func NewAPI(subnets []*ipamTypes.Subnet, vpcs []*ipamTypes.VirtualNetwork, secur
 		errors:         map[Operation]error{},
 		delaySim:       helpers.NewDelaySimulator(),
 		pdSubnet:       pdCidr,
+
+		trunkAssociations: map[string]trunkAssociation{},
 	}
 
 	api.UpdateSubnets(subnets)
@@ -538,6 +553,72 @@ func (e *API) UnassignENIPrefixes(ctx context.Context, eniID string, prefixes []
 	return fmt.Errorf("Unable to find ENI with ID %s", eniID)
 }
 
+// findENI returns the ENI with the given ID, whether attached or not, or nil
+// if no such ENI exists. e.mutex must be held when calling this function.
+func (e *API) findENI(eniID string) *eniTypes.ENI {
+	if eni, ok := e.unattached[eniID]; ok {
+		return eni
+	}
+	for _, enis := range e.enis {
+		if eni, ok := enis[eniID]; ok {
+			return eni
+		}
+	}
+	return nil
+}
+
+func (e *API) AssociateTrunkInterface(ctx context.Context, trunkENI, branchENI string, vlanID int32) (string, error) {
+	e.rateLimit()
+	e.delaySim.Delay(AssociateTrunkInterface)
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err, ok := e.errors[AssociateTrunkInterface]; ok {
+		return "", err
+	}
+
+	if e.findENI(trunkENI) == nil {
+		return "", fmt.Errorf("Unable to find trunk ENI with ID %s", trunkENI)
+	}
+	if e.findENI(branchENI) == nil {
+		return "", fmt.Errorf("Unable to find branch ENI with ID %s", branchENI)
+	}
+
+	for _, assoc := range e.trunkAssociations {
+		if assoc.trunkENI == trunkENI && assoc.vlanID == vlanID {
+			return "", fmt.Errorf("VLAN ID %d already in use on trunk ENI %s", vlanID, trunkENI)
+		}
+	}
+
+	associationID := "trunk-assoc-" + uuid.New().String()
+	e.trunkAssociations[associationID] = trunkAssociation{
+		trunkENI:  trunkENI,
+		branchENI: branchENI,
+		vlanID:    vlanID,
+	}
+	return associationID, nil
+}
+
+func (e *API) DisassociateTrunkInterface(ctx context.Context, associationID string) error {
+	e.rateLimit()
+	e.delaySim.Delay(DisassociateTrunkInterface)
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if err, ok := e.errors[DisassociateTrunkInterface]; ok {
+		return err
+	}
+
+	if _, ok := e.trunkAssociations[associationID]; !ok {
+		return fmt.Errorf("Unable to find trunk interface association %s", associationID)
+	}
+
+	delete(e.trunkAssociations, associationID)
+	return nil
+}
+
 func (e *API) GetInstance(ctx context.Context, vpcs ipamTypes.VirtualNetworkMap, subnets ipamTypes.SubnetMap, instanceID string) (*ipamTypes.Instance, error) {
 	instance := ipamTypes.Instance{}
 	instance.Interfaces = map[string]ipamTypes.InterfaceRevision{}
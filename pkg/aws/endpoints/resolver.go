@@ -4,6 +4,9 @@
 package endpoints
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 
 	operatorOption "github.com/cilium/cilium/operator/option"
@@ -17,12 +20,75 @@ var (
 
 func Resolver(service, region string) (aws.Endpoint, error) {
 	if ep := operatorOption.Config.EC2APIEndpoint; len(ep) > 0 && service == "ec2" {
+		url, err := endpointURL(ep)
+		if err != nil {
+			return aws.Endpoint{}, err
+		}
+		if err := validateEndpoint(ep, region); err != nil {
+			return aws.Endpoint{}, err
+		}
 		log.Debugf("Using custom API endpoint %s for service %s in region %s", ep, service, region)
 		// See https://docs.aws.amazon.com/sdk-for-go/v2/api/aws/endpoints/#hdr-Using_Custom_Endpoints
 		return aws.Endpoint{
-			URL: "https://" + ep,
+			URL: url,
+		}, nil
+	}
+
+	if operatorOption.Config.EC2UseDualStackEndpoint && service == "ec2" {
+		url := dualStackURL(region)
+		log.Debugf("Using dual-stack API endpoint %s for service %s in region %s", url, service, region)
+		return aws.Endpoint{
+			URL: url,
 		}, nil
 	}
 
 	return aws.Endpoint{}, &aws.EndpointNotFoundError{}
 }
+
+// endpointURL turns the configured endpoint into an URL. A bare host name is
+// accepted for backwards compatibility, any explicit scheme other than https
+// is rejected.
+func endpointURL(endpoint string) (string, error) {
+	if strings.Contains(endpoint, "://") {
+		if !strings.HasPrefix(endpoint, "https://") {
+			return "", fmt.Errorf("invalid EC2 endpoint %q: only https endpoints are supported", endpoint)
+		}
+		return endpoint, nil
+	}
+	return "https://" + endpoint, nil
+}
+
+// validateEndpoint rejects AWS-provided endpoints belonging to another region
+// or partition. AWS-provided host names, including VPC interface endpoints
+// and the FIPS and dual-stack variants, embed the region they serve. Host
+// names outside of the known partitions, e.g. API proxies, are not validated.
+func validateEndpoint(endpoint, region string) error {
+	host := strings.TrimPrefix(endpoint, "https://")
+	if idx := strings.IndexAny(host, "/:"); idx >= 0 {
+		host = host[:idx]
+	}
+
+	isCNEndpoint := strings.HasSuffix(host, ".amazonaws.com.cn")
+	isAWSEndpoint := !isCNEndpoint && (strings.HasSuffix(host, ".amazonaws.com") || strings.HasSuffix(host, ".api.aws"))
+	if !isCNEndpoint && !isAWSEndpoint || region == "" {
+		return nil
+	}
+
+	if isCNEndpoint != strings.HasPrefix(region, "cn-") {
+		return fmt.Errorf("EC2 endpoint %q does not belong to the partition of region %q", host, region)
+	}
+	if !strings.Contains(host, region) {
+		return fmt.Errorf("EC2 endpoint %q does not serve region %q", host, region)
+	}
+
+	return nil
+}
+
+// dualStackURL returns the dual-stack (IPv4 and IPv6) EC2 endpoint of the
+// given region.
+func dualStackURL(region string) string {
+	if strings.HasPrefix(region, "cn-") {
+		return fmt.Sprintf("https://ec2.%s.api.amazonwebservices.com.cn", region)
+	}
+	return fmt.Sprintf("https://ec2.%s.api.aws", region)
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package endpoints
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointURL(t *testing.T) {
+	url, err := endpointURL("ec2-fips.us-west-1.amazonaws.com")
+	require.NoError(t, err)
+	require.Equal(t, "https://ec2-fips.us-west-1.amazonaws.com", url)
+
+	url, err = endpointURL("https://vpce-1234-abcd.ec2.us-west-1.vpce.amazonaws.com")
+	require.NoError(t, err)
+	require.Equal(t, "https://vpce-1234-abcd.ec2.us-west-1.vpce.amazonaws.com", url)
+
+	_, err = endpointURL("http://ec2.us-west-1.amazonaws.com")
+	require.Error(t, err)
+}
+
+func TestValidateEndpoint(t *testing.T) {
+	// AWS-provided endpoints serving the region are accepted
+	require.NoError(t, validateEndpoint("ec2-fips.us-west-1.amazonaws.com", "us-west-1"))
+	require.NoError(t, validateEndpoint("https://vpce-1234-abcd.ec2.us-west-1.vpce.amazonaws.com", "us-west-1"))
+	require.NoError(t, validateEndpoint("ec2.cn-north-1.amazonaws.com.cn", "cn-north-1"))
+	require.NoError(t, validateEndpoint("ec2.us-west-1.api.aws", "us-west-1"))
+
+	// Endpoints of another region or partition are rejected
+	require.Error(t, validateEndpoint("ec2.us-east-1.amazonaws.com", "us-west-1"))
+	require.Error(t, validateEndpoint("ec2.cn-north-1.amazonaws.com.cn", "us-west-1"))
+	require.Error(t, validateEndpoint("ec2.us-west-1.amazonaws.com", "cn-north-1"))
+
+	// Host names outside of the known partitions are not validated
+	require.NoError(t, validateEndpoint("ec2.example.com", "us-west-1"))
+}
+
+func TestDualStackURL(t *testing.T) {
+	require.Equal(t, "https://ec2.us-west-1.api.aws", dualStackURL("us-west-1"))
+	require.Equal(t, "https://ec2.cn-north-1.api.amazonwebservices.com.cn", dualStackURL("cn-north-1"))
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/prometheus/client_golang/prometheus"
+
 	"github.com/cilium/cilium/pkg/controller"
 	"github.com/cilium/cilium/pkg/ipam/types"
 	"github.com/cilium/cilium/pkg/time"
@@ -29,6 +31,12 @@ type GarbageCollectionParams struct {
 	MaxPerInterval int32
 	// ENITags is used to only garbage collect ENIs with this set of tags
 	ENITags types.Tags
+	// ReferencedENIs, if set, returns the set of ENI IDs which are still
+	// referenced by a CiliumNode. Referenced ENIs are never garbage
+	// collected, even if they appear detached to the EC2 API.
+	ReferencedENIs func() map[string]struct{}
+	// ReclaimedENIs, if set, is incremented for every garbage collected ENI
+	ReclaimedENIs prometheus.Counter
 }
 
 func StartENIGarbageCollector(ctx context.Context, api EC2API, params GarbageCollectionParams) {
@@ -43,11 +51,25 @@ func StartENIGarbageCollector(ctx context.Context, api EC2API, params GarbageCol
 			// ENI to be attached to a node, we wait for one run interval before we delete
 			// any ENIs. If the interface has been attached by the next run interval,
 			// the deletion will fail and the interface will not be garbage collected.
+			var referenced map[string]struct{}
+			if params.ReferencedENIs != nil {
+				referenced = params.ReferencedENIs()
+			}
+
 			for _, eniID := range enisMarkedForDeletion {
+				if _, ok := referenced[eniID]; ok {
+					log.WithField("eniID", eniID).Debug("Not garbage collecting ENI referenced by a CiliumNode")
+					continue
+				}
+
 				log.WithField("eniID", eniID).Debug("Garbage collecting ENI")
 				err := api.DeleteNetworkInterface(ctx, eniID)
 				if err != nil {
 					log.WithError(err).Debug("Failed to garbage collect ENI")
+					continue
+				}
+				if params.ReclaimedENIs != nil {
+					params.ReclaimedENIs.Inc()
 				}
 			}
 
@@ -8,6 +8,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 
 	ec2mock "github.com/cilium/cilium/pkg/aws/ec2/mock"
@@ -129,3 +131,61 @@ func TestStartENIGarbageCollector(t *testing.T) {
 	ec2api.TagENI(context.TODO(), newENI, tags)
 	require.NoError(t, err)
 }
+
+func eniGCSuccessCount() int64 {
+	for _, st := range controllerManager.GetStatusModel() {
+		if st.Name == gcENIControllerName {
+			return st.Status.SuccessCount
+		}
+	}
+	return 0
+}
+
+// TestENIGarbageCollectorReferencedENIs tests that ENIs still referenced by a
+// CiliumNode are never garbage collected and that reclaimed ENIs are counted.
+func TestENIGarbageCollectorReferencedENIs(t *testing.T) {
+	tags := map[string]string{
+		"cilium-managed": "true",
+	}
+
+	ec2api := ec2mock.NewAPI(subnets, vpcs, securityGroups)
+	require.NotNil(t, ec2api)
+
+	var eniIDs []string
+	for i := 0; i < 3; i++ {
+		eniID, _, err := ec2api.CreateNetworkInterface(context.TODO(), 0, "subnet-1", "desc", []string{"sg-1"}, false)
+		require.NoError(t, err)
+		require.NoError(t, ec2api.TagENI(context.TODO(), eniID, tags))
+		eniIDs = append(eniIDs, eniID)
+	}
+	protectedENI := eniIDs[0]
+
+	reclaimedENIs := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "test_eni_gc_reclaimed_total",
+	})
+
+	runs := eniGCSuccessCount()
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	StartENIGarbageCollector(ctx, ec2api, GarbageCollectionParams{
+		RunInterval:    0, // for testing, we're triggering the controller manually
+		MaxPerInterval: 25,
+		ENITags:        tags,
+		ReferencedENIs: func() map[string]struct{} {
+			return map[string]struct{}{protectedENI: {}}
+		},
+		ReclaimedENIs: reclaimedENIs,
+	})
+
+	// The first run only marks the ENIs for deletion
+	waitForControllerRun(t, controllerManager, gcENIControllerName, runs+1)
+
+	// The second run deletes the marked ENIs, except the referenced one
+	controllerManager.TriggerController(gcENIControllerName)
+	waitForControllerRun(t, controllerManager, gcENIControllerName, runs+2)
+
+	enis, err := ec2api.GetDetachedNetworkInterfaces(context.TODO(), nil, 25)
+	require.NoError(t, err)
+	require.Equal(t, []string{protectedENI}, enis)
+	require.Equal(t, float64(2), testutil.ToFloat64(reclaimedENIs))
+}
@@ -38,6 +38,8 @@ type EC2API interface {
 	UnassignPrivateIpAddresses(ctx context.Context, eniID string, addresses []string) error
 	AssignENIPrefixes(ctx context.Context, eniID string, prefixes int32) error
 	UnassignENIPrefixes(ctx context.Context, eniID string, prefixes []string) error
+	AssociateTrunkInterface(ctx context.Context, trunkENI, branchENI string, vlanID int32) (string, error)
+	DisassociateTrunkInterface(ctx context.Context, associationID string) error
 	GetInstanceTypes(context.Context) ([]ec2_types.InstanceTypeInfo, error)
 }
 
@@ -86,6 +88,21 @@ func (m *InstancesManager) GetPoolQuota() ipamTypes.PoolQuotaMap {
 	return pool
 }
 
+// AttachedENIs returns the IDs of all ENIs attached to the instances managed
+// by Cilium, i.e. the ENIs which are referenced by a CiliumNode
+func (m *InstancesManager) AttachedENIs() map[string]struct{} {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	attached := map[string]struct{}{}
+	m.instances.ForeachInterface("", func(instanceID, interfaceID string, iface ipamTypes.InterfaceRevision) error {
+		attached[interfaceID] = struct{}{}
+		return nil
+	})
+
+	return attached
+}
+
 // GetSubnet returns the subnet by subnet ID
 //
 // The returned subnet is immutable so it can be safely accessed
@@ -115,7 +132,15 @@ func (m *InstancesManager) GetSubnets(ctx context.Context) ipamTypes.SubnetMap {
 // availability zone within a provided list of subnet ids
 //
 // The returned subnet is immutable so it can be safely accessed
-func (m *InstancesManager) FindSubnetByIDs(vpcID, availabilityZone string, subnetIDs []string) (bestSubnet *ipamTypes.Subnet) {
+func (m *InstancesManager) FindSubnetByIDs(vpcID, availabilityZone string, subnetIDs []string) *ipamTypes.Subnet {
+	return mostAvailableSubnet(m.findSubnetsByIDs(vpcID, availabilityZone, subnetIDs))
+}
+
+// findSubnetsByIDs returns all subnets matching VPC ID and availability zone
+// within a provided list of subnet ids
+//
+// The returned subnets are immutable so they can be safely accessed
+func (m *InstancesManager) findSubnetsByIDs(vpcID, availabilityZone string, subnetIDs []string) (subnets []*ipamTypes.Subnet) {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
 
@@ -123,10 +148,8 @@ func (m *InstancesManager) FindSubnetByIDs(vpcID, availabilityZone string, subne
 		if s.VirtualNetworkID == vpcID && s.AvailabilityZone == availabilityZone {
 			for _, subnetID := range subnetIDs {
 				if s.ID == subnetID {
-					if bestSubnet == nil || bestSubnet.AvailableAddresses < s.AvailableAddresses {
-						bestSubnet = s
-					}
-					continue
+					subnets = append(subnets, s)
+					break
 				}
 			}
 		}
@@ -139,15 +162,32 @@ func (m *InstancesManager) FindSubnetByIDs(vpcID, availabilityZone string, subne
 // availability zone and all required tags
 //
 // The returned subnet is immutable so it can be safely accessed
-func (m *InstancesManager) FindSubnetByTags(vpcID, availabilityZone string, required ipamTypes.Tags) (bestSubnet *ipamTypes.Subnet) {
+func (m *InstancesManager) FindSubnetByTags(vpcID, availabilityZone string, required ipamTypes.Tags) *ipamTypes.Subnet {
+	return mostAvailableSubnet(m.findSubnetsByTags(vpcID, availabilityZone, required))
+}
+
+// findSubnetsByTags returns all subnets matching VPC ID, availability zone and
+// all required tags
+//
+// The returned subnets are immutable so they can be safely accessed
+func (m *InstancesManager) findSubnetsByTags(vpcID, availabilityZone string, required ipamTypes.Tags) (subnets []*ipamTypes.Subnet) {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
 
 	for _, s := range m.subnets {
 		if s.VirtualNetworkID == vpcID && s.AvailabilityZone == availabilityZone && s.Tags.Match(required) {
-			if bestSubnet == nil || bestSubnet.AvailableAddresses < s.AvailableAddresses {
-				bestSubnet = s
-			}
+			subnets = append(subnets, s)
+		}
+	}
+
+	return
+}
+
+// mostAvailableSubnet returns the subnet with the most available addresses
+func mostAvailableSubnet(subnets []*ipamTypes.Subnet) (bestSubnet *ipamTypes.Subnet) {
+	for _, s := range subnets {
+		if bestSubnet == nil || bestSubnet.AvailableAddresses < s.AvailableAddresses {
+			bestSubnet = s
 		}
 	}
 
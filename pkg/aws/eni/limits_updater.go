@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package eni
+
+import (
+	"context"
+
+	"github.com/cilium/cilium/pkg/aws/eni/limits"
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+const updateLimitsControllerName = "ipam-eni-limits-update"
+
+var updateLimitsControllerGroup = controller.NewGroup(updateLimitsControllerName)
+
+// StartENILimitsUpdater periodically refreshes the instance type ENI/IP limits
+// from the EC2 API, so that new instance families become usable without
+// requiring a new release embedding them in the static table. Failures are
+// logged and retried at the next interval, keeping the limits known so far.
+func StartENILimitsUpdater(ctx context.Context, api EC2API, interval time.Duration) {
+	log.WithField("interval", interval).Info("Starting to update instance type limits from the EC2 API")
+
+	controllerManager.UpdateController(updateLimitsControllerName, controller.ControllerParams{
+		Group: updateLimitsControllerGroup,
+		DoFunc: func(ctx context.Context) error {
+			return limits.UpdateFromEC2API(ctx, api)
+		},
+		RunInterval: interval,
+		Context:     ctx,
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	operatorOption "github.com/cilium/cilium/operator/option"
+	"github.com/cilium/cilium/pkg/annotation"
 	"github.com/cilium/cilium/pkg/aws/ec2"
 	"github.com/cilium/cilium/pkg/aws/eni/limits"
 	eniTypes "github.com/cilium/cilium/pkg/aws/eni/types"
@@ -71,6 +72,24 @@ type Node struct {
 
 	// instanceID of the node
 	instanceID string
+
+	// ipAllocationCandidates is the list of attached ENIs with capacity
+	// for additional IP addresses, as determined by the last call to
+	// PrepareIPAllocation. Protected by Node.mutex.
+	ipAllocationCandidates []ipAllocationCandidate
+
+	// prefixDelegationFallback is set when the node fell back from prefix
+	// delegation to allocation of individual secondary IP addresses because
+	// the subnet ran out of contiguous /28 prefixes. Protected by
+	// Node.mutex.
+	prefixDelegationFallback bool
+}
+
+// ipAllocationCandidate is an attached ENI on which additional IP addresses
+// can be assigned
+type ipAllocationCandidate struct {
+	eniID     string
+	available int
 }
 
 // NewNode returns a new Node
@@ -107,10 +126,26 @@ func (n *Node) PopulateStatusFields(k8sObj *v2.CiliumNode) {
 		func(instanceID, interfaceID string, rev ipamTypes.InterfaceRevision) error {
 			e, ok := rev.Resource.(*eniTypes.ENI)
 			if ok {
-				k8sObj.Status.ENI.ENIs[interfaceID] = *e.DeepCopy()
+				eni := *e.DeepCopy()
+				// The EC2 API does not carry an MTU attribute, re-derive it
+				// from the subnet options so it survives resyncs
+				eni.MTU = k8sObj.Spec.ENI.SubnetOptions[eni.Subnet.ID].MTU
+				k8sObj.Status.ENI.ENIs[interfaceID] = eni
 			}
 			return nil
 		})
+
+	n.mutex.RLock()
+	k8sObj.Status.ENI.PrefixDelegationFallback = n.prefixDelegationFallback
+	n.mutex.RUnlock()
+}
+
+// recordPrefixDelegationFallback records in the CiliumNode status that the
+// node fell back from prefix delegation to individual secondary IP addresses
+func (n *Node) recordPrefixDelegationFallback() {
+	n.mutex.Lock()
+	n.prefixDelegationFallback = true
+	n.mutex.Unlock()
 }
 
 // getLimits returns the interface and IP limits of this node
@@ -146,8 +181,15 @@ func (n *Node) PrepareIPRelease(excessIPs int, scopedLog *logrus.Entry) *ipam.Re
 	for _, eniId := range eniIds {
 		e := n.enis[eniId]
 
-		// IP release for prefixes is not currently supported. Will skip releasing from this ENI
+		// Prefix delegated ENIs are released at whole prefix granularity,
+		// to avoid leaving partially used prefixes attached to the ENI.
 		if len(e.Prefixes) > 0 {
+			freeIpsOnENI := n.findFreePrefixIPs(&e, excessIPs)
+			if len(freeIpsOnENI) > len(r.IPsToRelease) {
+				r.InterfaceID = eniId
+				r.PoolID = ipamTypes.PoolID(e.Subnet.ID)
+				r.IPsToRelease = freeIpsOnENI
+			}
 			continue
 		}
 		scopedLog.WithFields(logrus.Fields{
@@ -197,9 +239,119 @@ func (n *Node) PrepareIPRelease(excessIPs int, scopedLog *logrus.Entry) *ipam.Re
 	return r
 }
 
-// ReleaseIPs performs the ENI IP release operation
+// findFreePrefixIPs returns the addresses of the delegated prefixes of the
+// given ENI which have no address in use, up to the number of whole prefixes
+// fitting into excessIPs. Rounding down prevents the release of a prefix from
+// immediately re-triggering an allocation to resolve the resulting deficit.
+// n.mutex must be held when calling this method.
+func (n *Node) findFreePrefixIPs(e *eniTypes.ENI, excessIPs int) []string {
+	maxPrefixes := excessIPs / option.ENIPDBlockSizeIPv4
+	freeIPs := []string{}
+
+	for _, pfx := range e.Prefixes {
+		if len(freeIPs) >= maxPrefixes*option.ENIPDBlockSizeIPv4 {
+			break
+		}
+
+		ips, err := ip.PrefixToIps(pfx, 0)
+		if err != nil {
+			continue
+		}
+
+		free := true
+		for _, addr := range ips {
+			if _, used := n.k8sObj.Status.IPAM.Used[addr]; used || addr == e.IP {
+				free = false
+				break
+			}
+		}
+
+		if free {
+			freeIPs = append(freeIPs, ips...)
+		}
+	}
+
+	return freeIPs
+}
+
+// ReleaseIPs performs the ENI IP release operation. Addresses part of a
+// delegated prefix are released at whole prefix granularity, given that AWS
+// does not support unassigning individual addresses from a prefix; prefixes
+// with only a subset of their addresses selected for release are retained.
 func (n *Node) ReleaseIPs(ctx context.Context, r *ipam.ReleaseAction) error {
-	return n.manager.api.UnassignPrivateIpAddresses(ctx, r.InterfaceID, r.IPsToRelease)
+	n.mutex.RLock()
+	e, ok := n.enis[r.InterfaceID]
+	n.mutex.RUnlock()
+
+	if !ok || len(e.Prefixes) == 0 {
+		return n.manager.api.UnassignPrivateIpAddresses(ctx, r.InterfaceID, r.IPsToRelease)
+	}
+
+	toRelease := make(map[string]bool, len(r.IPsToRelease))
+	for _, addr := range r.IPsToRelease {
+		toRelease[addr] = true
+	}
+
+	var prefixes []string
+	prefixIPs := map[string]bool{}
+	for _, pfx := range e.Prefixes {
+		ips, err := ip.PrefixToIps(pfx, 0)
+		if err != nil {
+			return fmt.Errorf("unable to parse CIDR %s: %w", pfx, err)
+		}
+
+		complete := true
+		for _, addr := range ips {
+			prefixIPs[addr] = true
+			if !toRelease[addr] {
+				complete = false
+			}
+		}
+
+		if complete {
+			prefixes = append(prefixes, pfx)
+		}
+	}
+
+	if len(prefixes) > 0 {
+		if err := n.manager.api.UnassignENIPrefixes(ctx, r.InterfaceID, prefixes); err != nil {
+			return err
+		}
+	}
+
+	// Release the addresses which are not part of any delegated prefix
+	// individually.
+	addresses := []string{}
+	for _, addr := range r.IPsToRelease {
+		if !prefixIPs[addr] {
+			addresses = append(addresses, addr)
+		}
+	}
+
+	if len(addresses) > 0 {
+		return n.manager.api.UnassignPrivateIpAddresses(ctx, r.InterfaceID, addresses)
+	}
+
+	return nil
+}
+
+// requestedPoolsLocked returns the IPAM pools requested by the node via
+// multi-pool IPAM together with the number of IPv4 addresses needed out of
+// each pool. For the ENI allocator, the pool name refers to the ID of the
+// subnet to allocate from. nil is returned if the node does not request any
+// pools.
+//
+// n.mutex must be held when calling this function
+func (n *Node) requestedPoolsLocked() map[string]int {
+	requested := n.k8sObj.Spec.IPAM.Pools.Requested
+	if len(requested) == 0 {
+		return nil
+	}
+	pools := make(map[string]int, len(requested))
+	for _, r := range requested {
+		pools[r.Pool] = r.Needed.IPv4Addrs
+	}
+	return pools
 }
 
 // PrepareIPAllocation returns the number of ENI IPs and interfaces that can be
@@ -213,7 +365,31 @@ func (n *Node) PrepareIPAllocation(scopedLog *logrus.Entry) (a *ipam.AllocationA
 	a = &ipam.AllocationAction{}
 
 	n.mutex.RLock()
-	defer n.mutex.RUnlock()
+
+	// With prefix delegation, prefixes are only assigned on a single
+	// interface per maintenance round. Without it, the assignment is batched
+	// across all interfaces with capacity to resolve large deficits in a
+	// single round.
+	prefixDelegated := n.IsPrefixDelegated()
+	remainingInSubnet := map[string]int{}
+	var candidates []ipAllocationCandidate
+
+	// If the node requests specific pools, allocation is restricted to the
+	// subnets backing these pools and each pool is filled up to its own
+	// warm target.
+	requestedPools := n.requestedPoolsLocked()
+	var remainingInPool map[string]int
+	if requestedPools != nil {
+		remainingInPool = make(map[string]int, len(requestedPools))
+		for pool, needed := range requestedPools {
+			remainingInPool[pool] = needed
+		}
+		for _, e := range n.enis {
+			if remaining, ok := remainingInPool[e.Subnet.ID]; ok {
+				remainingInPool[e.Subnet.ID] = math.IntMax(remaining-len(e.Addresses), 0)
+			}
+		}
+	}
 
 	for key, e := range n.enis {
 		scopedLog.WithFields(logrus.Fields{
@@ -229,6 +405,11 @@ func (n *Node) PrepareIPAllocation(scopedLog *logrus.Entry) (a *ipam.AllocationA
 			continue
 		}
 
+		if _, ok := requestedPools[e.Subnet.ID]; requestedPools != nil && !ok {
+			scopedLog.WithField(fieldEniID, e.ID).Debug("ENI subnet does not back a requested pool")
+			continue
+		}
+
 		_, effectiveLimits := n.getEffectiveIPLimits(&e, limits.IPv4)
 		availableOnENI := math.IntMax(effectiveLimits-len(e.Addresses), 0)
 		if availableOnENI <= 0 {
@@ -242,20 +423,57 @@ func (n *Node) PrepareIPAllocation(scopedLog *logrus.Entry) (a *ipam.AllocationA
 			"availableOnEni": availableOnENI,
 		}).Debug("ENI has IPs available")
 
-		if subnet := n.manager.GetSubnet(e.Subnet.ID); subnet != nil {
-			if subnet.AvailableAddresses > 0 && a.InterfaceID == "" {
-				scopedLog.WithFields(logrus.Fields{
-					"subnetID":           e.Subnet.ID,
-					"availableAddresses": subnet.AvailableAddresses,
-				}).Debug("Subnet has IPs available")
+		subnet := n.manager.GetSubnet(e.Subnet.ID)
+		if subnet == nil {
+			continue
+		}
+		if _, ok := remainingInSubnet[subnet.ID]; !ok {
+			remainingInSubnet[subnet.ID] = subnet.AvailableAddresses
+		}
+		available := math.IntMin(remainingInSubnet[subnet.ID], availableOnENI)
+		if remainingInPool != nil {
+			available = math.IntMin(available, remainingInPool[subnet.ID])
+		}
+		if available <= 0 || (prefixDelegated && len(candidates) > 0) {
+			continue
+		}
 
-				a.InterfaceID = key
-				a.PoolID = ipamTypes.PoolID(subnet.ID)
-				a.IPv4.AvailableForAllocation = math.IntMin(subnet.AvailableAddresses, availableOnENI)
-			}
+		scopedLog.WithFields(logrus.Fields{
+			"subnetID":           e.Subnet.ID,
+			"availableAddresses": subnet.AvailableAddresses,
+		}).Debug("Subnet has IPs available")
+
+		if a.InterfaceID == "" {
+			a.InterfaceID = key
+			a.PoolID = ipamTypes.PoolID(subnet.ID)
 		}
+		remainingInSubnet[subnet.ID] -= available
+		if remainingInPool != nil {
+			remainingInPool[subnet.ID] -= available
+		}
+		a.IPv4.AvailableForAllocation += available
+		candidates = append(candidates, ipAllocationCandidate{eniID: key, available: available})
 	}
 	a.EmptyInterfaceSlots = limits.Adapters - len(n.enis)
+	n.mutex.RUnlock()
+
+	// Report requested pools which still have unmet demand but whose backing
+	// subnet has run out of addresses
+	for pool, remaining := range remainingInPool {
+		if remaining <= 0 {
+			continue
+		}
+		if subnet := n.manager.GetSubnet(pool); subnet == nil || subnet.AvailableAddresses == 0 {
+			scopedLog.WithFields(logrus.Fields{
+				"poolID":    pool,
+				"neededIPs": remaining,
+			}).Warning("Requested IPAM pool is exhausted")
+		}
+	}
+
+	n.mutex.Lock()
+	n.ipAllocationCandidates = candidates
+	n.mutex.Unlock()
 
 	return
 }
@@ -276,6 +494,7 @@ func (n *Node) AllocateIPs(ctx context.Context, a *ipam.AllocationAction) error
 	// Check if the interface to allocate on is prefix delegated
 	n.mutex.RLock()
 	isPrefixDelegated := n.node.Ops().IsPrefixDelegated()
+	candidates := n.ipAllocationCandidates
 	n.mutex.RUnlock()
 
 	if isPrefixDelegated {
@@ -289,8 +508,29 @@ func (n *Node) AllocateIPs(ctx context.Context, a *ipam.AllocationAction) error
 		n.loggerLocked().WithFields(logrus.Fields{
 			logfields.Node: n.k8sObj.Name,
 		}).Warning("Subnet might be out of prefixes, Cilium will not allocate prefixes on this node anymore")
+		n.recordPrefixDelegationFallback()
+	}
+
+	// Batch the assignment across all candidate ENIs to resolve large
+	// deficits in a single maintenance round
+	remaining := a.IPv4.AvailableForAllocation
+	for _, c := range candidates {
+		toAllocate := math.IntMin(remaining, c.available)
+		if toAllocate <= 0 {
+			continue
+		}
+		if err := n.manager.api.AssignPrivateIpAddresses(ctx, c.eniID, int32(toAllocate)); err != nil {
+			return err
+		}
+		remaining -= toAllocate
+		if remaining == 0 {
+			return nil
+		}
 	}
-	return n.manager.api.AssignPrivateIpAddresses(ctx, a.InterfaceID, int32(a.IPv4.AvailableForAllocation))
+	if remaining > 0 {
+		return n.manager.api.AssignPrivateIpAddresses(ctx, a.InterfaceID, int32(remaining))
+	}
+	return nil
 }
 
 func (n *Node) getSecurityGroupIDs(ctx context.Context, eniSpec eniTypes.ENISpec) ([]string, error) {
@@ -411,6 +651,7 @@ func (n *Node) CreateInterface(ctx context.Context, allocation *ipam.AllocationA
 	n.mutex.RLock()
 	resource := *n.k8sObj
 	isPrefixDelegated := n.node.Ops().IsPrefixDelegated()
+	n.overrideSubnetSelection(&resource.Spec.ENI)
 	n.mutex.RUnlock()
 
 	subnet := n.findSuitableSubnet(resource.Spec.ENI, limits)
@@ -434,7 +675,12 @@ func (n *Node) CreateInterface(ctx context.Context, allocation *ipam.AllocationA
 			fmt.Errorf("%s: %w", errUnableToGetSecurityGroups, err)
 	}
 
+	subnetOptions := resource.Spec.ENI.SubnetOptions[subnet.ID]
+
 	desc := "Cilium-CNI (" + n.node.InstanceID() + ")"
+	if subnetOptions.Description != "" {
+		desc = subnetOptions.Description
+	}
 
 	// Must allocate secondary ENI IPs as needed, up to ENI instance limit - 1 (reserve 1 for primary IP)
 	toAllocate := math.IntMin(allocation.IPv4.MaxIPsToAllocate, limits.IPv4-1)
@@ -459,6 +705,7 @@ func (n *Node) CreateInterface(ctx context.Context, allocation *ipam.AllocationA
 			// Subnet might be out of available /28 prefixes, but /32 IP addresses might be available.
 			// We should attempt to allocate /32 IPs.
 			scopedLog.WithField(logfields.Node, n.k8sObj.Name).Warning("Subnet might be out of prefixes, Cilium will not allocate prefixes on this node anymore")
+			n.recordPrefixDelegationFallback()
 			eniID, eni, err = n.manager.api.CreateNetworkInterface(ctx, int32(toAllocate), subnet.ID, desc, securityGroupIDs, false)
 		}
 		if err != nil {
@@ -472,6 +719,7 @@ func (n *Node) CreateInterface(ctx context.Context, allocation *ipam.AllocationA
 	if subnet.CIDR != nil {
 		eni.Subnet.CIDR = subnet.CIDR.String()
 	}
+	eni.MTU = subnetOptions.MTU
 
 	var attachmentID string
 	for attachRetries := 0; attachRetries < maxAttachRetries; attachRetries++ {
@@ -511,7 +759,7 @@ func (n *Node) CreateInterface(ctx context.Context, allocation *ipam.AllocationA
 
 	scopedLog.Info("Attached ENI to instance")
 
-	if resource.Spec.ENI.DeleteOnTermination == nil || *resource.Spec.ENI.DeleteOnTermination {
+	if resource.Spec.ENI.IsDeleteOnTermination(subnet.ID) {
 		// We have an attachment ID from the last API, which lets us mark the
 		// interface as delete on termination
 		err = n.manager.api.ModifyNetworkInterface(ctx, eniID, attachmentID, true)
@@ -839,17 +1087,68 @@ func (n *Node) getEffectiveIPLimits(eni *eniTypes.ENI, limits int) (leftoverPref
 	return leftoverPrefixCapacity, effectiveLimits
 }
 
+// overrideSubnetSelection applies the subnet selection annotations of the
+// CiliumNode resource, if any, to the given ENI spec, so that the ENIs of
+// individual nodes can be steered to designated subnets without changing the
+// cluster-wide configuration. Malformed annotations are ignored with a
+// warning. n.mutex must be held when calling this method.
+func (n *Node) overrideSubnetSelection(spec *eniTypes.ENISpec) {
+	if ids, ok := n.k8sObj.Annotations[annotation.IPAMENISubnetIDsKey]; ok {
+		spec.SubnetIDs = strings.Split(strings.ReplaceAll(ids, " ", ""), ",")
+	}
+
+	if value, ok := n.k8sObj.Annotations[annotation.IPAMENISubnetTagsKey]; ok {
+		tags, err := parseSubnetTags(value)
+		if err != nil {
+			n.loggerLocked().WithError(err).
+				Warningf("Ignoring malformed %s annotation", annotation.IPAMENISubnetTagsKey)
+			return
+		}
+		spec.SubnetTags = tags
+	}
+}
+
+// parseSubnetTags parses a comma-separated list of key=value subnet tags.
+func parseSubnetTags(value string) (map[string]string, error) {
+	tags := make(map[string]string)
+	for _, tag := range strings.Split(value, ",") {
+		key, val, ok := strings.Cut(tag, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid tag %q, expected key=value", tag)
+		}
+		tags[strings.TrimSpace(key)] = strings.TrimSpace(val)
+	}
+	return tags, nil
+}
+
 // findSuitableSubnet attempts to find a subnet to allocate an ENI in according to the following heuristic.
 //  0. In general, the subnet has to be in the same VPC and match the availability zone of the
 //     node. If there are multiple candidates, we choose the subnet with the most addresses
 //     available.
-//  1. If we have explicit ID or tag constraints, chose a matching subnet. ID constraints take
+//  1. If the node requests specific pools via multi-pool IPAM, chose among the subnets backing
+//     these pools.
+//  2. If we have explicit ID or tag constraints, chose a matching subnet. ID constraints take
 //     precedence.
-//  2. If we have no explicit constraints, try to use the subnet the first ENI of the node was
+//  3. If we have no explicit constraints, try to use the subnet the first ENI of the node was
 //     created in, to avoid putting the ENI in a surprising subnet if possible.
-//  3. If none of these work, fall back to just choosing the subnet with the most addresses
+//  4. If none of these work, fall back to just choosing the subnet with the most addresses
 //     available.
 func (n *Node) findSuitableSubnet(spec eniTypes.ENISpec, limits ipamTypes.Limits) *ipamTypes.Subnet {
+	n.mutex.RLock()
+	requestedPools := n.requestedPoolsLocked()
+	n.mutex.RUnlock()
+	if requestedPools != nil {
+		poolIDs := make([]string, 0, len(requestedPools))
+		for pool := range requestedPools {
+			poolIDs = append(poolIDs, pool)
+		}
+		return mostAvailableSubnet(n.manager.findSubnetsByIDs(spec.VpcID, spec.AvailabilityZone, poolIDs))
+	}
+
+	if spec.SpreadSubnets != nil && *spec.SpreadSubnets {
+		return n.findLeastUsedSubnet(spec)
+	}
+
 	var subnet *ipamTypes.Subnet
 	if len(spec.SubnetIDs) > 0 {
 		return n.manager.FindSubnetByIDs(spec.VpcID, spec.AvailabilityZone, spec.SubnetIDs)
@@ -864,3 +1163,37 @@ func (n *Node) findSuitableSubnet(spec eniTypes.ENISpec, limits ipamTypes.Limits
 
 	return n.manager.FindSubnetByTags(spec.VpcID, spec.AvailabilityZone, nil)
 }
+
+// findLeastUsedSubnet returns the eligible subnet to which the fewest ENIs of
+// this node are attached, spreading the ENIs across all eligible subnets
+// instead of exhausting the fullest subnet first. Ties are broken in favor of
+// the subnet with the most available addresses. Explicit ID and tag
+// constraints limit the set of eligible subnets as in findSuitableSubnet.
+func (n *Node) findLeastUsedSubnet(spec eniTypes.ENISpec) *ipamTypes.Subnet {
+	var candidates []*ipamTypes.Subnet
+	if len(spec.SubnetIDs) > 0 {
+		candidates = n.manager.findSubnetsByIDs(spec.VpcID, spec.AvailabilityZone, spec.SubnetIDs)
+	} else {
+		candidates = n.manager.findSubnetsByTags(spec.VpcID, spec.AvailabilityZone, spec.SubnetTags)
+	}
+
+	attachedENIs := make(map[string]int)
+	n.mutex.RLock()
+	for _, e := range n.enis {
+		attachedENIs[e.Subnet.ID]++
+	}
+	n.mutex.RUnlock()
+
+	var subnet *ipamTypes.Subnet
+	for _, s := range candidates {
+		if s.AvailableAddresses == 0 {
+			continue
+		}
+		if subnet == nil || attachedENIs[s.ID] < attachedENIs[subnet.ID] ||
+			(attachedENIs[s.ID] == attachedENIs[subnet.ID] && s.AvailableAddresses > subnet.AvailableAddresses) {
+			subnet = s
+		}
+	}
+
+	return subnet
+}
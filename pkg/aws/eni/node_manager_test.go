@@ -680,6 +680,140 @@ func TestInterfaceCreatedInInitialSubnet(t *testing.T) {
 	eniNode.mutex.RUnlock()
 }
 
+// TestNodeManagerPoolRequests tests that allocation is restricted to the
+// subnets backing the pools requested via multi-pool IPAM
+//
+// - m5.large (3x ENIs, 2x10-2 IPs)
+// - MinAllocate 0
+// - MaxAllocate 0
+// - PreAllocate 8
+// - FirstInterfaceIndex 0
+// - Requested pools: s-2 (8 IPs)
+func TestNodeManagerPoolRequests(t *testing.T) {
+	setup(t)
+
+	const instanceID = "i-testNodeManagerPoolRequests-0"
+
+	testSubnet2 := &ipamTypes.Subnet{
+		ID:                 "s-2",
+		AvailabilityZone:   "us-west-1",
+		VirtualNetworkID:   "vpc-1",
+		AvailableAddresses: 100,
+		Tags:               ipamTypes.Tags{"k": "v"},
+	}
+
+	ec2api := ec2mock.NewAPI([]*ipamTypes.Subnet{testSubnet, testSubnet2}, []*ipamTypes.VirtualNetwork{testVpc}, testSecurityGroups)
+	instances := NewInstancesManager(ec2api)
+	require.NotNil(t, instances)
+	eniID1, _, err := ec2api.CreateNetworkInterface(context.TODO(), 0, testSubnet.ID, "desc", []string{"sg1", "sg2"}, false)
+	require.NoError(t, err)
+	_, err = ec2api.AttachNetworkInterface(context.TODO(), 0, instanceID, eniID1)
+	require.NoError(t, err)
+	instances.Resync(context.TODO())
+	mngr, err := ipam.NewNodeManager(instances, k8sapi, metricsapi, 10, false, false)
+	require.NoError(t, err)
+	require.NotNil(t, mngr)
+
+	// Announce node requesting the pool backed by subnet s-2, wait for IPs
+	// to become available
+	cn := newCiliumNode("node1", withTestDefaults(), withInstanceID(instanceID), withInstanceType("m5.large"), withIPAMPreAllocate(8))
+	cn.Spec.IPAM.Pools.Requested = []ipamTypes.IPAMPoolRequest{
+		{Pool: testSubnet2.ID, Needed: ipamTypes.IPAMPoolDemand{IPv4Addrs: 8}},
+	}
+	mngr.Upsert(cn)
+	require.NoError(t, testutils.WaitUntil(func() bool { return reachedAddressesNeeded(mngr, "node1", 0) }, 5*time.Second))
+
+	node := mngr.Get("node1")
+	require.NotNil(t, node)
+	require.Equal(t, 8, node.Stats().IPv4.AvailableIPs)
+	require.Equal(t, 0, node.Stats().IPv4.UsedIPs)
+
+	// The pre-existing ENI in subnet s-1 must not have received any IPs,
+	// all addresses must come from an ENI in the requested pool's subnet
+	eniNode, castOK := node.Ops().(*Node)
+	require.True(t, castOK)
+	eniNode.mutex.RLock()
+	require.Len(t, eniNode.enis, 2)
+	for _, eni := range eniNode.enis {
+		if eni.ID == eniID1 {
+			require.Empty(t, eni.Addresses)
+			continue
+		}
+		require.Equal(t, testSubnet2.ID, eni.Subnet.ID)
+		require.Len(t, eni.Addresses, 8)
+	}
+	eniNode.mutex.RUnlock()
+}
+
+// TestNodeManagerSubnetOptions tests that per-subnet interface options are
+// applied to created ENIs
+//
+// - m5.large (3x ENIs, 2x10-2 IPs)
+// - MinAllocate 0
+// - MaxAllocate 0
+// - PreAllocate 16
+// - FirstInterfaceIndex 0
+// - SubnetOptions for s-1: description, MTU, DeleteOnTermination
+func TestNodeManagerSubnetOptions(t *testing.T) {
+	setup(t)
+
+	const instanceID = "i-testNodeManagerSubnetOptions-0"
+
+	ec2api := ec2mock.NewAPI([]*ipamTypes.Subnet{testSubnet}, []*ipamTypes.VirtualNetwork{testVpc}, testSecurityGroups)
+	instances := NewInstancesManager(ec2api)
+	require.NotNil(t, instances)
+	eniID1, _, err := ec2api.CreateNetworkInterface(context.TODO(), 0, testSubnet.ID, "desc", []string{"sg1", "sg2"}, false)
+	require.NoError(t, err)
+	_, err = ec2api.AttachNetworkInterface(context.TODO(), 0, instanceID, eniID1)
+	require.NoError(t, err)
+	instances.Resync(context.TODO())
+	mngr, err := ipam.NewNodeManager(instances, k8sapi, metricsapi, 10, false, false)
+	require.NoError(t, err)
+	require.NotNil(t, mngr)
+
+	// Announce node with interface options for the test subnet, wait for
+	// IPs to become available
+	deleteOnTermination := false
+	cn := newCiliumNode("node1", withTestDefaults(), withInstanceID(instanceID), withInstanceType("m5.large"), withIPAMPreAllocate(16))
+	cn.Spec.ENI.SubnetOptions = map[string]eniTypes.ENISubnetOptions{
+		testSubnet.ID: {
+			Description:         "jumbo frames",
+			MTU:                 9001,
+			DeleteOnTermination: &deleteOnTermination,
+		},
+	}
+	mngr.Upsert(cn)
+	require.NoError(t, testutils.WaitUntil(func() bool { return reachedAddressesNeeded(mngr, "node1", 0) }, 5*time.Second))
+
+	node := mngr.Get("node1")
+	require.NotNil(t, node)
+
+	// The second ENI created to resolve the deficit must carry the
+	// overridden description
+	eniNode, castOK := node.Ops().(*Node)
+	require.True(t, castOK)
+	var eniID2 string
+	eniNode.mutex.RLock()
+	require.Len(t, eniNode.enis, 2)
+	for _, eni := range eniNode.enis {
+		if eni.ID == eniID1 {
+			continue
+		}
+		eniID2 = eni.ID
+		require.Equal(t, "jumbo frames", eni.Description)
+	}
+	eniNode.mutex.RUnlock()
+	require.NotEmpty(t, eniID2)
+
+	// The MTU must be exported via the ENI status for the datapath to apply
+	obj := cn.DeepCopy()
+	node.Ops().PopulateStatusFields(obj)
+	require.Equal(t, 9001, obj.Status.ENI.ENIs[eniID2].MTU)
+
+	require.False(t, cn.Spec.ENI.IsDeleteOnTermination(testSubnet.ID))
+	require.True(t, cn.Spec.ENI.IsDeleteOnTermination("s-other"))
+}
+
 type nodeState struct {
 	cn           *v2.CiliumNode
 	name         string
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cilium/cilium/pkg/annotation"
 	"github.com/cilium/cilium/pkg/aws/eni/types"
 	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
 )
@@ -51,3 +52,88 @@ func TestGetUsedIPWithPrefixes(t *testing.T) {
 	n.k8sObj.Status.IPAM.Used = allocationMap
 	require.Equal(t, 32, n.GetUsedIPWithPrefixes())
 }
+
+// TestOverrideSubnetSelection tests the subnet selection override via CiliumNode annotations.
+func TestOverrideSubnetSelection(t *testing.T) {
+	cn := newCiliumNode("node1", withInstanceType("m5a.large"))
+	n := &Node{k8sObj: cn}
+
+	spec := types.ENISpec{SubnetIDs: []string{"s-1"}, SubnetTags: map[string]string{"team": "a"}}
+
+	// Without annotations, the spec shall be left untouched.
+	n.overrideSubnetSelection(&spec)
+	require.Equal(t, []string{"s-1"}, spec.SubnetIDs)
+	require.Equal(t, map[string]string{"team": "a"}, spec.SubnetTags)
+
+	cn.Annotations = map[string]string{
+		annotation.IPAMENISubnetIDsKey:  "s-2, s-3",
+		annotation.IPAMENISubnetTagsKey: "team=b,env=prod",
+	}
+	n.overrideSubnetSelection(&spec)
+	require.Equal(t, []string{"s-2", "s-3"}, spec.SubnetIDs)
+	require.Equal(t, map[string]string{"team": "b", "env": "prod"}, spec.SubnetTags)
+
+	// Malformed tags shall be ignored, preserving the previous selection.
+	cn.Annotations[annotation.IPAMENISubnetTagsKey] = "not-a-tag"
+	n.overrideSubnetSelection(&spec)
+	require.Equal(t, map[string]string{"team": "b", "env": "prod"}, spec.SubnetTags)
+}
+
+// TestFindLeastUsedSubnet tests the subnet spreading allocation strategy.
+func TestFindLeastUsedSubnet(t *testing.T) {
+	mngr := NewInstancesManager(nil)
+	mngr.subnets = ipamTypes.SubnetMap{
+		"subnet-1": {ID: "subnet-1", VirtualNetworkID: "vpc-1", AvailabilityZone: "us-west-1", AvailableAddresses: 100},
+		"subnet-2": {ID: "subnet-2", VirtualNetworkID: "vpc-1", AvailabilityZone: "us-west-1", AvailableAddresses: 50},
+		"subnet-3": {ID: "subnet-3", VirtualNetworkID: "vpc-1", AvailabilityZone: "us-west-1", AvailableAddresses: 0},
+	}
+
+	spread := true
+	cn := newCiliumNode("node1", withInstanceType("m5a.large"))
+	n := &Node{manager: mngr, k8sObj: cn, enis: map[string]types.ENI{}}
+	spec := types.ENISpec{VpcID: "vpc-1", AvailabilityZone: "us-west-1", SpreadSubnets: &spread}
+
+	// Without attached ENIs, the subnet with the most available addresses wins.
+	subnet := n.findSuitableSubnet(spec, ipamTypes.Limits{})
+	require.NotNil(t, subnet)
+	require.Equal(t, "subnet-1", subnet.ID)
+
+	// With an ENI in subnet-1, the next ENI goes to subnet-2. Subnets without
+	// available addresses are never considered.
+	n.enis["eni-1"] = types.ENI{ID: "eni-1", Subnet: types.AwsSubnet{ID: "subnet-1"}}
+	subnet = n.findSuitableSubnet(spec, ipamTypes.Limits{})
+	require.NotNil(t, subnet)
+	require.Equal(t, "subnet-2", subnet.ID)
+
+	// With one ENI in each eligible subnet, availability breaks the tie again.
+	n.enis["eni-2"] = types.ENI{ID: "eni-2", Subnet: types.AwsSubnet{ID: "subnet-2"}}
+	subnet = n.findSuitableSubnet(spec, ipamTypes.Limits{})
+	require.NotNil(t, subnet)
+	require.Equal(t, "subnet-1", subnet.ID)
+
+	// Explicit subnet ID constraints are honored.
+	spec.SubnetIDs = []string{"subnet-2"}
+	subnet = n.findSuitableSubnet(spec, ipamTypes.Limits{})
+	require.NotNil(t, subnet)
+	require.Equal(t, "subnet-2", subnet.ID)
+}
+
+// TestFindFreePrefixIPs tests the selection of fully unused delegated prefixes for release.
+func TestFindFreePrefixIPs(t *testing.T) {
+	cn := newCiliumNode("node1", withInstanceType("m5a.large"))
+	n := &Node{k8sObj: cn}
+	eni := &types.ENI{Prefixes: []string{"10.10.128.0/28", "10.10.128.16/28"}}
+
+	allocationMap := make(ipamTypes.AllocationMap)
+	allocationMap["10.10.128.2"] = ipamTypes.AllocationIP{Resource: "eni-1"}
+	n.k8sObj.Status.IPAM.Used = allocationMap
+
+	// Only the second prefix has no address in use.
+	freeIPs := n.findFreePrefixIPs(eni, 32)
+	require.Len(t, freeIPs, 16)
+	require.Contains(t, freeIPs, "10.10.128.16")
+	require.Contains(t, freeIPs, "10.10.128.31")
+
+	// No whole prefix fits into the excess IP count.
+	require.Empty(t, n.findFreePrefixIPs(eni, 15))
+}
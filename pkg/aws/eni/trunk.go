@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package eni
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+const (
+	// minVlanID and maxVlanID delimit the range of VLAN IDs usable for
+	// branch to trunk ENI associations
+	minVlanID = 1
+	maxVlanID = 4094
+)
+
+// BranchENI represents a branch interface associated with a trunk ENI, created
+// to provide a pod with a dedicated set of security groups.
+type BranchENI struct {
+	// ID is the ENI ID of the branch interface
+	ID string
+
+	// AssociationID identifies the association with the trunk ENI
+	AssociationID string
+
+	// VlanID is the VLAN the branch interface is associated on
+	VlanID int32
+}
+
+// BranchENIManager manages the branch ENIs associated with the trunk ENIs of
+// the cluster nodes, to implement security groups per pod. A pod annotated
+// with a dedicated security group set gets a branch interface created in the
+// given subnet and associated with the trunk ENI of its node over a VLAN.
+//
+// Branch ENIs are created with the standard ENI creation tags, so that the
+// ones leaked by missed deletions are cleaned up by the ENI garbage collector.
+// Usage can be observed through the EC2 API call metrics of the
+// AssociateTrunkInterface and DisassociateTrunkInterface operations.
+type BranchENIManager struct {
+	api EC2API
+
+	mutex lock.Mutex
+	// vlans tracks the VLAN IDs in use on each trunk ENI
+	vlans map[string]map[int32]struct{}
+}
+
+// NewBranchENIManager returns a new branch ENI manager using the given API.
+func NewBranchENIManager(api EC2API) *BranchENIManager {
+	return &BranchENIManager{
+		api:   api,
+		vlans: map[string]map[int32]struct{}{},
+	}
+}
+
+// reserveVlanID reserves the next free VLAN ID on the given trunk ENI.
+func (m *BranchENIManager) reserveVlanID(trunkENI string) (int32, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	used, ok := m.vlans[trunkENI]
+	if !ok {
+		used = map[int32]struct{}{}
+		m.vlans[trunkENI] = used
+	}
+
+	for id := int32(minVlanID); id <= maxVlanID; id++ {
+		if _, ok := used[id]; !ok {
+			used[id] = struct{}{}
+			return id, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no VLAN ID available on trunk ENI %s", trunkENI)
+}
+
+// releaseVlanID releases a VLAN ID reserved on the given trunk ENI.
+func (m *BranchENIManager) releaseVlanID(trunkENI string, vlanID int32) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if used, ok := m.vlans[trunkENI]; ok {
+		delete(used, vlanID)
+		if len(used) == 0 {
+			delete(m.vlans, trunkENI)
+		}
+	}
+}
+
+// AllocateBranchENI creates a branch interface in the given subnet with the
+// requested security groups, and associates it with the trunk ENI on the next
+// free VLAN. The creation is undone if the association fails.
+func (m *BranchENIManager) AllocateBranchENI(ctx context.Context, trunkENI, subnetID string, securityGroups []string) (*BranchENI, error) {
+	vlanID, err := m.reserveVlanID(trunkENI)
+	if err != nil {
+		return nil, err
+	}
+
+	desc := "Cilium-CNI branch (" + trunkENI + ")"
+	eniID, _, err := m.api.CreateNetworkInterface(ctx, 0, subnetID, desc, securityGroups, false)
+	if err != nil {
+		m.releaseVlanID(trunkENI, vlanID)
+		return nil, fmt.Errorf("unable to create branch ENI: %w", err)
+	}
+
+	associationID, err := m.api.AssociateTrunkInterface(ctx, trunkENI, eniID, vlanID)
+	if err != nil {
+		if delErr := m.api.DeleteNetworkInterface(ctx, eniID); delErr != nil {
+			log.WithError(delErr).WithField(fieldEniID, eniID).
+				Warning("Unable to undo branch ENI creation after failure to associate")
+		}
+		m.releaseVlanID(trunkENI, vlanID)
+		return nil, fmt.Errorf("unable to associate branch ENI with trunk %s: %w", trunkENI, err)
+	}
+
+	return &BranchENI{ID: eniID, AssociationID: associationID, VlanID: vlanID}, nil
+}
+
+// ReleaseBranchENI disassociates the given branch interface from its trunk ENI
+// and deletes it, typically upon deletion of the owning pod.
+func (m *BranchENIManager) ReleaseBranchENI(ctx context.Context, trunkENI string, branch *BranchENI) error {
+	if err := m.api.DisassociateTrunkInterface(ctx, branch.AssociationID); err != nil {
+		return fmt.Errorf("unable to disassociate branch ENI from trunk %s: %w", trunkENI, err)
+	}
+
+	if err := m.api.DeleteNetworkInterface(ctx, branch.ID); err != nil {
+		return fmt.Errorf("unable to delete branch ENI: %w", err)
+	}
+
+	m.releaseVlanID(trunkENI, branch.VlanID)
+	return nil
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package eni
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ec2mock "github.com/cilium/cilium/pkg/aws/ec2/mock"
+	ipamTypes "github.com/cilium/cilium/pkg/ipam/types"
+)
+
+func TestBranchENIManager(t *testing.T) {
+	ec2api := ec2mock.NewAPI([]*ipamTypes.Subnet{testSubnet}, []*ipamTypes.VirtualNetwork{testVpc}, testSecurityGroups)
+	trunkENI, _, err := ec2api.CreateNetworkInterface(context.TODO(), 0, "s-1", "desc", []string{"sg-1"}, false)
+	require.NoError(t, err)
+
+	mngr := NewBranchENIManager(ec2api)
+
+	branch1, err := mngr.AllocateBranchENI(context.TODO(), trunkENI, "s-1", []string{"sg-2"})
+	require.NoError(t, err)
+	require.NotEmpty(t, branch1.ID)
+	require.NotEmpty(t, branch1.AssociationID)
+	require.EqualValues(t, 1, branch1.VlanID)
+
+	// A subsequent allocation shall use the next free VLAN ID.
+	branch2, err := mngr.AllocateBranchENI(context.TODO(), trunkENI, "s-1", []string{"sg-2"})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, branch2.VlanID)
+
+	// The released VLAN ID shall be reusable.
+	require.NoError(t, mngr.ReleaseBranchENI(context.TODO(), trunkENI, branch1))
+	branch3, err := mngr.AllocateBranchENI(context.TODO(), trunkENI, "s-1", []string{"sg-2"})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, branch3.VlanID)
+
+	// Releasing an unknown branch ENI shall fail.
+	require.Error(t, mngr.ReleaseBranchENI(context.TODO(), trunkENI,
+		&BranchENI{ID: "unknown", AssociationID: "unknown", VlanID: 3}))
+}
@@ -140,6 +140,58 @@ type ENISpec struct {
 	//
 	// +kubebuilder:validation:Optional
 	DisablePrefixDelegation *bool `json:"disable-prefix-delegation,omitempty"`
+
+	// SpreadSubnets determines whether the ENIs of this node should be
+	// distributed across all eligible subnets instead of preferring the
+	// subnet with the most available addresses. This reduces the impact of
+	// a single subnet running out of addresses.
+	//
+	// +kubebuilder:validation:Optional
+	SpreadSubnets *bool `json:"spread-subnets,omitempty"`
+
+	// SubnetOptions are interface options applied to ENIs created in a
+	// specific subnet, keyed by subnet ID. They allow VPC designs in which
+	// individual subnets require a different MTU, interface description or
+	// lifecycle behavior.
+	//
+	// +kubebuilder:validation:Optional
+	SubnetOptions map[string]ENISubnetOptions `json:"subnet-options,omitempty"`
+}
+
+// ENISubnetOptions are interface options applied to ENIs created in a
+// specific subnet. They override the corresponding node-wide settings of the
+// ENI specification.
+type ENISubnetOptions struct {
+	// Description overrides the description of ENIs created in this
+	// subnet. If empty, the standard Cilium-CNI description is used.
+	//
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+
+	// MTU is the MTU to use for ENIs created in this subnet. EC2 network
+	// interfaces carry no MTU attribute, the value is exported via the ENI
+	// status for the datapath on the node to apply. If zero, the default
+	// MTU is used.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	MTU int `json:"mtu,omitempty"`
+
+	// DeleteOnTermination overrides DeleteOnTermination of the ENI
+	// specification for ENIs created in this subnet.
+	//
+	// +kubebuilder:validation:Optional
+	DeleteOnTermination *bool `json:"delete-on-termination,omitempty"`
+}
+
+// IsDeleteOnTermination returns whether an ENI created in the given subnet
+// must be deleted when the associated instance is terminated, taking
+// per-subnet overrides into account.
+func (e *ENISpec) IsDeleteOnTermination(subnetID string) bool {
+	if options, ok := e.SubnetOptions[subnetID]; ok && options.DeleteOnTermination != nil {
+		return *options.DeleteOnTermination
+	}
+	return e.DeleteOnTermination == nil || *e.DeleteOnTermination
 }
 
 // ENI represents an AWS Elastic Network Interface
@@ -172,6 +224,13 @@ type ENI struct {
 	// +optional
 	Description string `json:"description,omitempty"`
 
+	// MTU is the MTU to configure on the interface as derived from the
+	// subnet options of the ENI specification. If zero, the default MTU
+	// applies
+	//
+	// +optional
+	MTU int `json:"mtu,omitempty"`
+
 	// Number is the interface index, it used in combination with
 	// FirstInterfaceIndex
 	//
@@ -198,6 +257,18 @@ type ENI struct {
 	// +optional
 	Prefixes []string `json:"prefixes,omitempty"`
 
+	// IPv6Addresses is the list of all IPv6 addresses associated with the
+	// ENI
+	//
+	// +optional
+	IPv6Addresses []string `json:"ipv6-addresses,omitempty"`
+
+	// IPv6Prefixes is the list of all /80 IPv6 prefixes associated with
+	// the ENI
+	//
+	// +optional
+	IPv6Prefixes []string `json:"ipv6-prefixes,omitempty"`
+
 	// SecurityGroups are the security groups associated with the ENI
 	SecurityGroups []string `json:"security-groups,omitempty"`
 
@@ -250,6 +321,13 @@ type ENIStatus struct {
 	//
 	// +optional
 	ENIs map[string]ENI `json:"enis,omitempty"`
+
+	// PrefixDelegationFallback is set when the node fell back from /28
+	// prefix delegation to allocation of individual secondary IP addresses
+	// because the subnet ran out of contiguous prefixes
+	//
+	// +optional
+	PrefixDelegationFallback bool `json:"prefix-delegation-fallback,omitempty"`
 }
 
 // AwsSubnet stores information regarding an AWS subnet
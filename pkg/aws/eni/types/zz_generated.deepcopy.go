@@ -60,6 +60,16 @@ func (in *ENI) DeepCopyInto(out *ENI) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.IPv6Addresses != nil {
+		in, out := &in.IPv6Addresses, &out.IPv6Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6Prefixes != nil {
+		in, out := &in.IPv6Prefixes, &out.IPv6Prefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.SecurityGroups != nil {
 		in, out := &in.SecurityGroups, &out.SecurityGroups
 		*out = make([]string, len(*in))
@@ -139,6 +149,18 @@ func (in *ENISpec) DeepCopyInto(out *ENISpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SpreadSubnets != nil {
+		in, out := &in.SpreadSubnets, &out.SpreadSubnets
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SubnetOptions != nil {
+		in, out := &in.SubnetOptions, &out.SubnetOptions
+		*out = make(map[string]ENISubnetOptions, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -174,3 +196,24 @@ func (in *ENIStatus) DeepCopy() *ENIStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ENISubnetOptions) DeepCopyInto(out *ENISubnetOptions) {
+	*out = *in
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ENISubnetOptions.
+func (in *ENISubnetOptions) DeepCopy() *ENISubnetOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ENISubnetOptions)
+	in.DeepCopyInto(out)
+	return out
+}
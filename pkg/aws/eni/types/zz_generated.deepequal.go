@@ -80,6 +80,9 @@ func (in *ENI) DeepEqual(other *ENI) bool {
 	if in.Description != other.Description {
 		return false
 	}
+	if in.MTU != other.MTU {
+		return false
+	}
 	if in.Number != other.Number {
 		return false
 	}
@@ -125,6 +128,40 @@ func (in *ENI) DeepEqual(other *ENI) bool {
 		}
 	}
 
+	if ((in.IPv6Addresses != nil) && (other.IPv6Addresses != nil)) || ((in.IPv6Addresses == nil) != (other.IPv6Addresses == nil)) {
+		in, other := &in.IPv6Addresses, &other.IPv6Addresses
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
+	if ((in.IPv6Prefixes != nil) && (other.IPv6Prefixes != nil)) || ((in.IPv6Prefixes == nil) != (other.IPv6Prefixes == nil)) {
+		in, other := &in.IPv6Prefixes, &other.IPv6Prefixes
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
 	if ((in.SecurityGroups != nil) && (other.SecurityGroups != nil)) || ((in.SecurityGroups == nil) != (other.SecurityGroups == nil)) {
 		in, other := &in.SecurityGroups, &other.SecurityGroups
 		if other == nil {
@@ -326,6 +363,35 @@ func (in *ENISpec) DeepEqual(other *ENISpec) bool {
 		}
 	}
 
+	if (in.SpreadSubnets == nil) != (other.SpreadSubnets == nil) {
+		return false
+	} else if in.SpreadSubnets != nil {
+		if *in.SpreadSubnets != *other.SpreadSubnets {
+			return false
+		}
+	}
+
+	if ((in.SubnetOptions != nil) && (other.SubnetOptions != nil)) || ((in.SubnetOptions == nil) != (other.SubnetOptions == nil)) {
+		in, other := &in.SubnetOptions, &other.SubnetOptions
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for key, inValue := range *in {
+				if otherValue, present := (*other)[key]; !present {
+					return false
+				} else {
+					if !inValue.DeepEqual(&otherValue) {
+						return false
+					}
+				}
+			}
+		}
+	}
+
 	return true
 }
 
@@ -357,5 +423,33 @@ func (in *ENIStatus) DeepEqual(other *ENIStatus) bool {
 		}
 	}
 
+	if in.PrefixDelegationFallback != other.PrefixDelegationFallback {
+		return false
+	}
+
+	return true
+}
+
+// DeepEqual is an autogenerated deepequal function, deeply comparing the
+// receiver with other. in must be non-nil.
+func (in *ENISubnetOptions) DeepEqual(other *ENISubnetOptions) bool {
+	if other == nil {
+		return false
+	}
+
+	if in.Description != other.Description {
+		return false
+	}
+	if in.MTU != other.MTU {
+		return false
+	}
+	if (in.DeleteOnTermination == nil) != (other.DeleteOnTermination == nil) {
+		return false
+	} else if in.DeleteOnTermination != nil {
+		if *in.DeleteOnTermination != *other.DeleteOnTermination {
+			return false
+		}
+	}
+
 	return true
 }
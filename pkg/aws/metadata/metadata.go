@@ -6,20 +6,70 @@ package metadata
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 
 	"github.com/cilium/cilium/pkg/safeio"
 )
 
+const (
+	// imdsV2OnlyEnv, when set to "true", disables the IMDSv1 fallback of
+	// the metadata client so that only token-based IMDSv2 requests are
+	// issued. Token fetch failures, e.g. due to a too low response hop
+	// limit for containerized deployments, then surface as errors instead
+	// of a silent downgrade to IMDSv1.
+	imdsV2OnlyEnv = "CILIUM_AWS_IMDSV2_ONLY"
+
+	// The following environment variables allow running without access to
+	// the instance metadata service. If all of them are set, the instance
+	// metadata is taken from the environment and IMDS is never contacted.
+	instanceIDEnv       = "CILIUM_AWS_INSTANCE_ID"
+	instanceTypeEnv     = "CILIUM_AWS_INSTANCE_TYPE"
+	availabilityZoneEnv = "CILIUM_AWS_AVAILABILITY_ZONE"
+	vpcIDEnv            = "CILIUM_AWS_VPC_ID"
+	subnetIDEnv         = "CILIUM_AWS_SUBNET_ID"
+)
+
 func newClient() (*imds.Client, error) {
 	cfg, err := config.LoadDefaultConfig(context.TODO())
 	if err != nil {
 		return nil, err
 	}
 
-	return imds.NewFromConfig(cfg), nil
+	return imds.NewFromConfig(cfg, func(o *imds.Options) {
+		if strings.EqualFold(os.Getenv(imdsV2OnlyEnv), "true") {
+			o.EnableFallback = aws.FalseTernary
+		}
+	}), nil
+}
+
+// staticInstanceMetadata returns the instance metadata configured through the
+// environment. ok is false if none of the environment variables are set. An
+// error is returned for a partial configuration to avoid mixing static
+// metadata with metadata retrieved from IMDS.
+func staticInstanceMetadata() (values []string, ok bool, err error) {
+	keys := []string{instanceIDEnv, instanceTypeEnv, availabilityZoneEnv, vpcIDEnv, subnetIDEnv}
+	var missing []string
+	for _, key := range keys {
+		value, found := os.LookupEnv(key)
+		if !found {
+			missing = append(missing, key)
+		}
+		values = append(values, value)
+	}
+
+	switch {
+	case len(missing) == len(keys):
+		return nil, false, nil
+	case len(missing) > 0:
+		return nil, false, fmt.Errorf("incomplete static instance metadata, missing %s", strings.Join(missing, ", "))
+	}
+
+	return values, true, nil
 }
 
 func getMetadata(client *imds.Client, path string) (string, error) {
@@ -41,6 +91,12 @@ func getMetadata(client *imds.Client, path string) (string, error) {
 
 // GetInstanceMetadata returns required AWS metadatas
 func GetInstanceMetadata() (instanceID, instanceType, availabilityZone, vpcID, subnetID string, err error) {
+	if values, ok, err := staticInstanceMetadata(); err != nil {
+		return "", "", "", "", "", err
+	} else if ok {
+		return values[0], values[1], values[2], values[3], values[4], nil
+	}
+
 	client, err := newClient()
 	if err != nil {
 		return
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticInstanceMetadata(t *testing.T) {
+	// Without environment variables, static metadata is not used
+	values, ok, err := staticInstanceMetadata()
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Nil(t, values)
+
+	// A partial configuration is an error
+	t.Setenv(instanceIDEnv, "i-1")
+	_, _, err = staticInstanceMetadata()
+	require.Error(t, err)
+
+	t.Setenv(instanceTypeEnv, "m5.large")
+	t.Setenv(availabilityZoneEnv, "us-west-1a")
+	t.Setenv(vpcIDEnv, "vpc-1")
+	t.Setenv(subnetIDEnv, "subnet-1")
+
+	instanceID, instanceType, availabilityZone, vpcID, subnetID, err := GetInstanceMetadata()
+	require.NoError(t, err)
+	require.Equal(t, "i-1", instanceID)
+	require.Equal(t, "m5.large", instanceType)
+	require.Equal(t, "us-west-1a", availabilityZone)
+	require.Equal(t, "vpc-1", vpcID)
+	require.Equal(t, "subnet-1", subnetID)
+}
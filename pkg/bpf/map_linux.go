@@ -817,6 +817,10 @@ func (m *Map) Lookup(key MapKey) (MapValue, error) {
 func (m *Map) Update(key MapKey, value MapValue) error {
 	var err error
 
+	if err = verifyMapOwnership(); err != nil {
+		return err
+	}
+
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -968,6 +972,10 @@ func (m *Map) delete(key MapKey, ignoreMissing bool) (_ bool, err error) {
 // SilentDelete deletes the map entry corresponding to the given key.
 // If a map entry is not found this returns (false, nil).
 func (m *Map) SilentDelete(key MapKey) (deleted bool, err error) {
+	if err := verifyMapOwnership(); err != nil {
+		return false, err
+	}
+
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -976,6 +984,10 @@ func (m *Map) SilentDelete(key MapKey) (deleted bool, err error) {
 
 // Delete deletes the map entry corresponding to the given key.
 func (m *Map) Delete(key MapKey) error {
+	if err := verifyMapOwnership(); err != nil {
+		return err
+	}
+
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -992,6 +1004,10 @@ func (m *Map) scopedLogger() *logrus.Entry {
 // entries. Note that if entries are added while the taversal is in progress,
 // such entries may survive the deletion process.
 func (m *Map) DeleteAll() error {
+	if err := verifyMapOwnership(); err != nil {
+		return err
+	}
+
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	defer m.updatePressureMetric()
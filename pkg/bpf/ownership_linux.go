@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+//go:build linux
+
+package bpf
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cilium/ebpf"
+	"github.com/google/uuid"
+
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+const (
+	// OwnershipMapName is the name of the pinned map acting as an
+	// ownership fencing marker for all Cilium BPF maps on this node.
+	OwnershipMapName = "cilium_agent_ownership"
+
+	// ownershipCheckInterval is the minimum interval between two reads of
+	// the ownership marker from the kernel. Mutations between two checks
+	// rely on the last observed state to avoid a per-operation syscall.
+	ownershipCheckInterval = time.Second
+)
+
+// ErrMapOwnershipLost is returned for map mutations once another agent
+// instance has taken over the ownership marker.
+var ErrMapOwnershipLost = errors.New("BPF map ownership lost: another agent instance has taken over this node")
+
+// ownershipValue is the single entry of the ownership map. It records which
+// agent instance currently owns the BPF maps of this node and how often
+// ownership changed hands.
+type ownershipValue struct {
+	InstanceID [16]byte
+	Generation uint64
+}
+
+var ownership struct {
+	mutex lock.Mutex
+
+	// m is the pinned ownership map. If nil, fencing is disabled (unit
+	// tests, command line tools).
+	m *ebpf.Map
+
+	instanceID [16]byte
+	generation uint64
+	lastCheck  time.Time
+	lost       bool
+}
+
+// AcquireMapOwnership claims the ownership fencing marker for this agent
+// instance by bumping the generation of the pinned ownership map. Any other
+// agent instance still running against the same bpffs will notice the foreign
+// instance ID on its next mutation and fail loudly instead of corrupting
+// shared LB/CT map state.
+func AcquireMapOwnership() error {
+	ownership.mutex.Lock()
+	defer ownership.mutex.Unlock()
+
+	spec := &ebpf.MapSpec{
+		Name:       OwnershipMapName,
+		Type:       ebpf.Array,
+		KeySize:    4,
+		ValueSize:  24,
+		MaxEntries: 1,
+		Pinning:    ebpf.PinByName,
+	}
+	m, err := OpenOrCreateMap(spec, TCGlobalsPath())
+	if err != nil {
+		return fmt.Errorf("unable to open ownership map: %w", err)
+	}
+
+	var current ownershipValue
+	if err := m.Lookup(uint32(0), &current); err != nil {
+		m.Close()
+		return fmt.Errorf("unable to read ownership map: %w", err)
+	}
+
+	next := ownershipValue{
+		InstanceID: [16]byte(uuid.New()),
+		Generation: current.Generation + 1,
+	}
+	if err := m.Update(uint32(0), &next, ebpf.UpdateAny); err != nil {
+		m.Close()
+		return fmt.Errorf("unable to claim ownership map: %w", err)
+	}
+
+	ownership.m = m
+	ownership.instanceID = next.InstanceID
+	ownership.generation = next.Generation
+	ownership.lastCheck = time.Now()
+	ownership.lost = false
+
+	if current.Generation > 0 {
+		log.WithField("generation", next.Generation).
+			Info("Claimed BPF map ownership from a previous agent instance")
+	}
+
+	return nil
+}
+
+// verifyMapOwnership checks that this agent instance still owns the BPF maps
+// of this node. The check against the kernel is rate limited to at most one
+// lookup per ownershipCheckInterval; in between, the last observed state is
+// returned. Once ownership is lost it is never silently regained.
+func verifyMapOwnership() error {
+	ownership.mutex.Lock()
+	defer ownership.mutex.Unlock()
+
+	if ownership.m == nil {
+		return nil
+	}
+	if ownership.lost {
+		return ErrMapOwnershipLost
+	}
+	if time.Since(ownership.lastCheck) < ownershipCheckInterval {
+		return nil
+	}
+	ownership.lastCheck = time.Now()
+
+	var current ownershipValue
+	if err := ownership.m.Lookup(uint32(0), &current); err != nil {
+		// Failure to read the marker must not block map mutations, the
+		// fence only exists to catch a concurrent writer.
+		log.WithError(err).Warn("Unable to verify BPF map ownership")
+		return nil
+	}
+
+	if current.InstanceID != ownership.instanceID || current.Generation != ownership.generation {
+		ownership.lost = true
+		log.WithField("generation", current.Generation).
+			Error("BPF map ownership has been taken over by another agent instance, refusing further map mutations")
+		return ErrMapOwnershipLost
+	}
+
+	return nil
+}
@@ -826,6 +826,17 @@ const (
 	RemoteClustersStatusNotReadyOnly
 )
 
+// syncStatus formats the synchronization status of a given resource type,
+// including the time of the last completed synchronization (if any), to help
+// pinpointing which watcher is possibly stuck.
+func syncStatus(synced bool, lastSync strfmt.DateTime) string {
+	status := fmt.Sprintf("%t", synced)
+	if !time.Time(lastSync).IsZero() {
+		status += fmt.Sprintf(" (last: %s)", timeSince(time.Time(lastSync)))
+	}
+	return status
+}
+
 func FormatStatusResponseRemoteClusters(w io.Writer, clusters []*models.RemoteCluster, verbosity RemoteClustersStatusVerbosity) {
 	for _, cluster := range clusters {
 		if verbosity != RemoteClustersStatusNotReadyOnly || !cluster.Ready {
@@ -853,8 +864,11 @@ func FormatStatusResponseRemoteClusters(w io.Writer, clusters []*models.RemoteCl
 			fmt.Fprint(w, "\n")
 
 			if cluster.Synced != nil {
-				fmt.Fprintf(w, "   └  synchronization status: nodes=%v, endpoints=%v, identities=%v, services=%v\n",
-					cluster.Synced.Nodes, cluster.Synced.Endpoints, cluster.Synced.Identities, cluster.Synced.Services)
+				fmt.Fprintf(w, "   └  synchronization status: nodes=%s, endpoints=%s, identities=%s, services=%s\n",
+					syncStatus(cluster.Synced.Nodes, cluster.Synced.NodesLastSync),
+					syncStatus(cluster.Synced.Endpoints, cluster.Synced.EndpointsLastSync),
+					syncStatus(cluster.Synced.Identities, cluster.Synced.IdentitiesLastSync),
+					syncStatus(cluster.Synced.Services, cluster.Synced.ServicesLastSync))
 			}
 		}
 	}
@@ -37,4 +37,6 @@ var Cell = cell.Module(
 	metrics.Metric(common.MetricsProvider(subsystem)),
 
 	cell.Invoke(ipsetSyncer),
+	cell.Invoke(registerHealthReporter),
+	cell.Invoke(registerMonitorEventSink),
 )
@@ -163,18 +163,36 @@ func (cm *ClusterMesh) NewRemoteCluster(name string, status common.StatusFunc) c
 		status:                 status,
 		storeFactory:           cm.conf.StoreFactory,
 		remoteIdentityWatcher:  cm.conf.RemoteIdentityWatcher,
+		scope:                  cm.conf.ResourceScopeFor(name),
 		synced:                 newSynced(),
 	}
+
+	// Mark the resource types excluded from the import scope as synchronized,
+	// given that they will never be watched.
+	if !rc.scope.Nodes {
+		close(rc.synced.nodes)
+	}
+	if !rc.scope.Services {
+		rc.synced.services.Stop()
+	}
+	if !rc.scope.Identities {
+		rc.synced.identities.Done()
+	}
+	if !rc.scope.IPCache {
+		close(rc.synced.ipcache)
+	}
 	rc.remoteNodes = cm.conf.StoreFactory.NewWatchStore(
 		name,
 		nodeStore.ValidatingKeyCreator(
 			nodeStore.ClusterNameValidator(name),
 			nodeStore.NameValidator(),
 			nodeStore.ClusterIDValidator(&rc.clusterID),
+			nodeStore.EncryptionValidator(cm.conf.ClusterMeshRequireEncryption),
 		),
 		nodeStore.NewNodeObserver(cm.conf.NodeObserver, source.ClusterMesh),
 		store.RWSWithOnSyncCallback(func(ctx context.Context) { close(rc.synced.nodes) }),
 		store.RWSWithEntriesMetric(cm.conf.Metrics.TotalNodes.WithLabelValues(cm.conf.ClusterInfo.Name, cm.nodeName, rc.name)),
+		store.RWSWithEventsCoalescing(cm.conf.ClusterMeshEventsCoalescingWindow),
 	)
 
 	rc.remoteServices = cm.conf.StoreFactory.NewWatchStore(
@@ -193,8 +211,10 @@ func (cm *ClusterMesh) NewRemoteCluster(name string, status common.StatusFunc) c
 			func(svc *serviceStore.ClusterService) {
 				cm.conf.ServiceMerger.MergeExternalServiceDelete(svc, rc.synced.services)
 			},
+			common.WithServiceFilter(cm.conf.ServiceFilterFor(name)),
 		),
 		store.RWSWithOnSyncCallback(func(ctx context.Context) { rc.synced.services.Stop() }),
+		store.RWSWithEventsCoalescing(cm.conf.ClusterMeshEventsCoalescingWindow),
 	)
 
 	rc.ipCacheWatcher = ipcache.NewIPIdentityWatcher(
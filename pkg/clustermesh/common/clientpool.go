@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package common
+
+import (
+	"context"
+
+	"github.com/cilium/cilium/pkg/kvstore"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// etcdClientPool allows sharing a single etcd client among the remote
+// clusters whose configuration points to the same etcd cluster (e.g., the
+// local KVStoreMesh cache in hub-and-spoke meshes), to reduce the number of
+// open connections and the associated keepalive load. Clients are keyed by
+// the semantic digest of the corresponding etcd configuration file, and
+// reference counted, so that the underlying connection is closed only when
+// the last remote cluster using it is disconnected.
+type etcdClientPool struct {
+	mutex   lock.Mutex
+	clients map[string]*pooledClient
+}
+
+// pooledClient tracks a shareable etcd client, along with its reference
+// count. ready is set once the connection has been successfully established,
+// as only established clients can be shared.
+type pooledClient struct {
+	backend kvstore.BackendOperations
+	refs    int
+	ready   bool
+}
+
+func newEtcdClientPool() *etcdClientPool {
+	return &etcdClientPool{clients: map[string]*pooledClient{}}
+}
+
+// acquire returns an additional reference to the established client
+// associated with the given key, if any, or nil otherwise. The returned
+// error channel, mirroring the kvstore.NewClient one, is already closed,
+// given that the connection is known to be established.
+func (p *etcdClientPool) acquire(key string) (kvstore.BackendOperations, chan error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	entry, ok := p.clients[key]
+	if !ok || !entry.ready {
+		return nil, nil
+	}
+
+	entry.refs++
+	errch := make(chan error)
+	close(errch)
+	return &sharedBackend{BackendOperations: entry.backend, pool: p, key: key}, errch
+}
+
+// insert registers the given newly created client for sharing under the
+// given key, returning the wrapped backend and error channel to be used in
+// its place. The client becomes shareable once the connection establishment
+// completes successfully. If another client is already registered for the
+// same key (e.g., because its establishment is still in progress), the
+// client is returned unmodified, and not pooled.
+func (p *etcdClientPool) insert(key string, backend kvstore.BackendOperations, errch chan error) (kvstore.BackendOperations, chan error) {
+	entry := &pooledClient{backend: backend, refs: 1}
+
+	p.mutex.Lock()
+	if _, ok := p.clients[key]; ok {
+		p.mutex.Unlock()
+		return backend, errch
+	}
+	p.clients[key] = entry
+	p.mutex.Unlock()
+
+	out := make(chan error, 1)
+	go func() {
+		defer close(out)
+
+		failed := false
+		for err := range errch {
+			failed = true
+			out <- err
+		}
+
+		if !failed {
+			p.mutex.Lock()
+			entry.ready = true
+			p.mutex.Unlock()
+		}
+	}()
+
+	return &sharedBackend{BackendOperations: backend, pool: p, key: key}, out
+}
+
+// release drops a reference to the client associated with the given key,
+// closing the underlying connection when the last reference is dropped.
+func (p *etcdClientPool) release(ctx context.Context, key string) {
+	p.mutex.Lock()
+	entry, ok := p.clients[key]
+	if !ok {
+		p.mutex.Unlock()
+		return
+	}
+
+	entry.refs--
+	last := entry.refs <= 0
+	if last {
+		delete(p.clients, key)
+	}
+	p.mutex.Unlock()
+
+	if last {
+		entry.backend.Close(ctx)
+	}
+}
+
+// sharedBackend wraps a pooled etcd client, turning Close into the release
+// of the corresponding reference, so that the underlying connection is
+// preserved while other remote clusters are still using it.
+type sharedBackend struct {
+	kvstore.BackendOperations
+
+	pool *etcdClientPool
+	key  string
+}
+
+func (sb *sharedBackend) Close(ctx context.Context) {
+	sb.pool.release(ctx, sb.key)
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/kvstore"
+)
+
+type fakePooledBackend struct {
+	kvstore.BackendOperations
+
+	closed bool
+}
+
+func (fb *fakePooledBackend) Close(context.Context) { fb.closed = true }
+
+func TestEtcdClientPool(t *testing.T) {
+	ctx := context.Background()
+	pool := newEtcdClientPool()
+
+	// No client has been registered yet for the given key.
+	backend, errch := pool.acquire("key1")
+	require.Nil(t, backend)
+	require.Nil(t, errch)
+
+	fake := &fakePooledBackend{}
+	in := make(chan error, 1)
+	first, firstErrs := pool.insert("key1", fake, in)
+	require.NotNil(t, first)
+
+	// The client cannot be shared until establishment completed.
+	backend, _ = pool.acquire("key1")
+	require.Nil(t, backend)
+
+	// Complete the connection establishment successfully.
+	close(in)
+	_, ok := <-firstErrs
+	require.False(t, ok)
+
+	require.Eventually(t, func() bool {
+		backend, errch = pool.acquire("key1")
+		return backend != nil
+	}, time.Second, 10*time.Millisecond)
+
+	// The error channel of a shared client is already closed.
+	_, ok = <-errch
+	require.False(t, ok)
+
+	// Closing one of the references shall not close the underlying client.
+	backend.Close(ctx)
+	require.False(t, fake.closed)
+
+	// Closing the last reference shall close the underlying client.
+	first.Close(ctx)
+	require.True(t, fake.closed)
+
+	// The key is no longer present in the pool afterwards.
+	backend, _ = pool.acquire("key1")
+	require.Nil(t, backend)
+}
+
+func TestEtcdClientPoolFailure(t *testing.T) {
+	ctx := context.Background()
+	pool := newEtcdClientPool()
+
+	fake := &fakePooledBackend{}
+	in := make(chan error, 1)
+	backend, errs := pool.insert("key1", fake, in)
+
+	// Propagate a connection establishment failure.
+	in <- errors.New("connection failed")
+	close(in)
+	err, ok := <-errs
+	require.True(t, ok)
+	require.ErrorContains(t, err, "connection failed")
+
+	// The caller closes the backend upon failure, dropping the pool entry.
+	backend.Close(ctx)
+	require.True(t, fake.closed)
+
+	shared, _ := pool.acquire("key1")
+	require.Nil(t, shared)
+}
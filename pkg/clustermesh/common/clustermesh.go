@@ -4,10 +4,18 @@
 package common
 
 import (
+	"cmp"
+	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cilium/hive/cell"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/pflag"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/clustermesh/types"
@@ -27,15 +35,508 @@ const (
 type Config struct {
 	// ClusterMeshConfig is the path to the clustermesh configuration directory.
 	ClusterMeshConfig string
+
+	// ClusterMeshReconnectBackoffBase is the initial interval between
+	// reconnection attempts to the kvstore of an unreachable remote
+	// cluster. The interval grows linearly with consecutive failures.
+	ClusterMeshReconnectBackoffBase time.Duration
+
+	// ClusterMeshReconnectBackoffMax caps the reconnection interval.
+	// Zero disables the cap.
+	ClusterMeshReconnectBackoffMax time.Duration
+
+	// ClusterMeshReconnectBackoffJitter is the maximum fraction of the
+	// reconnection interval added as random jitter.
+	ClusterMeshReconnectBackoffJitter float64
+
+	// ClusterMeshReconnectBackoffOverrides are per-cluster overrides of
+	// the backoff parameters, keyed by cluster name.
+	ClusterMeshReconnectBackoffOverrides map[string]string
+
+	// ClusterMeshEtcdOptionOverrides are per-cluster overrides of the etcd
+	// client options, keyed by cluster name.
+	ClusterMeshEtcdOptionOverrides map[string]string
+
+	// ClusterMeshDegradedSyncTimeout is the time after which a remote
+	// cluster that connected but did not complete the initial
+	// synchronization is marked as degraded. Zero disables the detection.
+	ClusterMeshDegradedSyncTimeout time.Duration
+
+	// ClusterMeshLazyConnections, when enabled, defers the establishment of
+	// the connection to each remote cluster until it is actually referenced
+	// (e.g., by a global service or a policy).
+	ClusterMeshLazyConnections bool
+
+	// ClusterMeshLazyIdleTimeout is the period after which the connection
+	// to a remote cluster that is no longer referenced is torn down, when
+	// lazy connections are enabled. Zero keeps established connections
+	// open forever.
+	ClusterMeshLazyIdleTimeout time.Duration
+
+	// ClusterMeshCircuitBreakerThreshold is the number of connection
+	// failures within the configured window after which reconnection
+	// attempts to a remote cluster are paused for the cool-down period.
+	// Zero disables the circuit breaker.
+	ClusterMeshCircuitBreakerThreshold int
+
+	// ClusterMeshCircuitBreakerWindow is the sliding window within which
+	// connection failures are counted by the circuit breaker.
+	ClusterMeshCircuitBreakerWindow time.Duration
+
+	// ClusterMeshCircuitBreakerCooldown is the period during which
+	// reconnection attempts are paused once the circuit breaker opened.
+	ClusterMeshCircuitBreakerCooldown time.Duration
+
+	// ClusterMeshIdentityRelevanceFiltering, when enabled, only caches the
+	// remote identities that are actually referenced by local policy
+	// selectors, fetching the others on demand upon lookup, to reduce the
+	// agent memory usage in meshes with a large number of remote identities.
+	ClusterMeshIdentityRelevanceFiltering bool
+
+	// ClusterMeshCompression is the compression algorithm applied to the
+	// payloads exchanged with the etcd clusters of remote clusters, among
+	// "gzip" and "none" (default). Compression trades CPU for bandwidth,
+	// and is hence mostly useful on WAN links.
+	ClusterMeshCompression string
+
+	// ClusterMeshCompressionOverrides are per-cluster overrides of the
+	// compression algorithm, keyed by cluster name.
+	ClusterMeshCompressionOverrides map[string]string
+
+	// ClusterMeshEventsCoalescingWindow is the window during which the node
+	// and service watch events received from remote clusters are accumulated
+	// before being processed, so that bursts of updates for the same key
+	// (e.g., from a resyncing remote cluster) result in a single datapath
+	// update. Zero disables coalescing.
+	ClusterMeshEventsCoalescingWindow time.Duration
+
+	// ClusterMeshServiceFilterNamespaces restricts, per cluster, the
+	// namespaces whose shared services are imported (`;`-separated list),
+	// keyed by cluster name. An empty value imports all namespaces.
+	ClusterMeshServiceFilterNamespaces map[string]string
+
+	// ClusterMeshServiceFilterLabels restricts, per cluster, the imported
+	// shared services to the ones matching the given label selector, keyed
+	// by cluster name. An empty value imports all shared services.
+	ClusterMeshServiceFilterLabels map[string]string
+
+	// ClusterMeshImportedResources restricts, per cluster, the resource
+	// types consumed from the shared kvstore prefixes (`;`-separated list
+	// among nodes, services, identities and ipcache), keyed by cluster name.
+	// An empty value imports all resource types.
+	ClusterMeshImportedResources map[string]string
+
+	// ClusterMeshConfigPathOverrides are per-cluster overrides of the etcd
+	// configuration path, keyed by cluster name. They allow selecting, per
+	// remote cluster, whether the agent connects directly to the remote etcd
+	// or through the local KVStoreMesh cache, to support incremental
+	// migrations of large meshes without a global switch.
+	ClusterMeshConfigPathOverrides map[string]string
+
+	// ClusterMeshRemovalDrainMode configures the handling of the known
+	// entries upon the removal of a remote cluster: "immediate" (default)
+	// drops them as soon as the cluster is disconnected, "delayed" drops
+	// them after the grace period configured through
+	// ClusterMeshRemovalDrainDelay, and "retain" keeps them until the agent
+	// is restarted.
+	ClusterMeshRemovalDrainMode string
+
+	// ClusterMeshRemovalDrainDelay is the grace period after which the
+	// entries known from a removed remote cluster are dropped, when the
+	// drain mode is "delayed".
+	ClusterMeshRemovalDrainDelay time.Duration
+
+	// ClusterMeshRemovalDrainOverrides are per-cluster overrides of the
+	// drain mode, keyed by cluster name.
+	ClusterMeshRemovalDrainOverrides map[string]string
+
+	// ClusterMeshStaleEntriesTTL is the period after which the entries
+	// imported from a remote cluster that remained disconnected are drained
+	// automatically, so that a permanently decommissioned cluster does not
+	// leave stale entries forever. Zero keeps them until the cluster is
+	// reconnected or explicitly removed.
+	ClusterMeshStaleEntriesTTL time.Duration
+
+	// ClusterMeshRequireEncryption, when enabled, refuses to import the
+	// nodes of remote clusters that do not advertise transparent encryption
+	// support (WireGuard or IPsec), so that cross-cluster traffic is never
+	// programmed towards an unencrypted path.
+	ClusterMeshRequireEncryption bool
+
+	// ClusterMeshPausedClusters marks, per cluster, whether the connection
+	// shall start administratively paused (e.g., cluster1=true), retaining
+	// the previously synchronized entries while stopping the processing of
+	// new events, for planned remote cluster maintenance windows. Paused
+	// clusters can be resumed at runtime through the API.
+	ClusterMeshPausedClusters map[string]string
+
+	// ClusterMeshProbeInterval is the interval between the lightweight
+	// probes (ranged get of the heartbeat key) measuring the round-trip
+	// latency towards the kvstore of each connected remote cluster. Zero
+	// disables the probing.
+	ClusterMeshProbeInterval time.Duration
+
+	// ClusterMeshSharedEtcdClients, when enabled, shares a single etcd
+	// client among the remote clusters whose configuration points to the
+	// same etcd cluster (same endpoints and credentials), to reduce the
+	// number of open connections and the associated keepalive load in
+	// hub-and-spoke meshes.
+	ClusterMeshSharedEtcdClients bool
+
+	// ClusterMeshConnectionPriorities are per-cluster priorities governing
+	// the order in which the connections to the remote clusters are
+	// established at startup (e.g., cluster1=10). Clusters with a higher
+	// priority are connected first, so that critical cross-cluster services
+	// recover before low-priority ones; unlisted clusters default to zero.
+	ClusterMeshConnectionPriorities map[string]string
+
+	// ClusterMeshReadinessGate, when enabled, marks the clustermesh health
+	// reporter as degraded until the connections to all remote clusters have
+	// been established and the initial synchronization completed, allowing
+	// orchestration to hold rollouts in the meanwhile.
+	ClusterMeshReadinessGate bool
 }
 
 func (def Config) Flags(flags *pflag.FlagSet) {
 	flags.String("clustermesh-config", def.ClusterMeshConfig, "Path to the ClusterMesh configuration directory")
+	flags.Duration("clustermesh-reconnect-backoff-base", def.ClusterMeshReconnectBackoffBase,
+		"Initial interval between reconnection attempts to the kvstore of an unreachable remote cluster, growing linearly with consecutive failures")
+	flags.Duration("clustermesh-reconnect-backoff-max", def.ClusterMeshReconnectBackoffMax,
+		"Maximum interval between reconnection attempts to the kvstore of an unreachable remote cluster (0 disables the cap)")
+	flags.Float64("clustermesh-reconnect-backoff-jitter", def.ClusterMeshReconnectBackoffJitter,
+		"Maximum fraction of the reconnection interval added as random jitter (e.g. 0.1 adds up to 10%)")
+	flags.StringToString("clustermesh-reconnect-backoff-overrides", def.ClusterMeshReconnectBackoffOverrides,
+		"Per-cluster overrides of the reconnection backoff parameters, e.g. cluster1=base:5s;max:5m;jitter:0.1")
+	flags.StringToString("clustermesh-etcd-option-overrides", def.ClusterMeshEtcdOptionOverrides,
+		"Per-cluster overrides of the etcd client options, e.g. cluster1=etcd.qps:20;etcd.maxInflight:100")
+	flags.Duration("clustermesh-degraded-sync-timeout", def.ClusterMeshDegradedSyncTimeout,
+		"Time after which a connected remote cluster that did not complete the initial synchronization is marked as degraded (0 disables the detection)")
+	flags.Bool("clustermesh-lazy-connections", def.ClusterMeshLazyConnections,
+		"Defer the establishment of the connection to each remote cluster until it is actually referenced")
+	flags.Duration("clustermesh-lazy-idle-timeout", def.ClusterMeshLazyIdleTimeout,
+		"Tear down the connection to a remote cluster no longer referenced for the given period, when lazy connections are enabled (0 keeps established connections open forever)")
+	flags.Int("clustermesh-circuit-breaker-threshold", def.ClusterMeshCircuitBreakerThreshold,
+		"Number of connection failures within the configured window after which reconnection attempts to a remote cluster are paused for the cool-down period (0 disables the circuit breaker)")
+	flags.Duration("clustermesh-circuit-breaker-window", def.ClusterMeshCircuitBreakerWindow,
+		"Sliding window within which connection failures to a remote cluster are counted by the circuit breaker")
+	flags.Duration("clustermesh-circuit-breaker-cooldown", def.ClusterMeshCircuitBreakerCooldown,
+		"Period during which reconnection attempts to a remote cluster are paused once the circuit breaker opened")
+	flags.Bool("clustermesh-identity-relevance-filtering", def.ClusterMeshIdentityRelevanceFiltering,
+		"Only cache the remote identities referenced by local policy selectors, fetching the others on demand")
+	flags.String("clustermesh-compression", def.ClusterMeshCompression,
+		"Compression algorithm applied to the payloads exchanged with the etcd clusters of remote clusters, among gzip and none")
+	flags.StringToString("clustermesh-compression-overrides", def.ClusterMeshCompressionOverrides,
+		"Per-cluster overrides of the compression algorithm, e.g. cluster1=gzip")
+	flags.Duration("clustermesh-events-coalescing-window", def.ClusterMeshEventsCoalescingWindow,
+		"Window during which the watch events received from remote clusters are accumulated before being processed (0 disables coalescing)")
+	flags.StringToString("clustermesh-service-filter-namespaces", def.ClusterMeshServiceFilterNamespaces,
+		"Per-cluster restriction of the namespaces whose shared services are imported, e.g. cluster1=kube-system;default")
+	flags.StringToString("clustermesh-service-filter-labels", def.ClusterMeshServiceFilterLabels,
+		"Per-cluster restriction of the imported shared services to the ones matching the given label selector, e.g. cluster1=tier=global")
+	flags.StringToString("clustermesh-imported-resources", def.ClusterMeshImportedResources,
+		"Per-cluster restriction of the resource types consumed from the remote cluster, e.g. cluster1=services;identities")
+	flags.StringToString("clustermesh-config-path-overrides", def.ClusterMeshConfigPathOverrides,
+		"Per-cluster overrides of the etcd configuration path, e.g. to connect directly to the remote etcd instead of through the local KVStoreMesh cache (cluster1=/path/to/etcd.config)")
+	flags.String("clustermesh-removal-drain-mode", def.ClusterMeshRemovalDrainMode,
+		"Handling of the known entries upon the removal of a remote cluster, among immediate, delayed and retain")
+	flags.Duration("clustermesh-removal-drain-delay", def.ClusterMeshRemovalDrainDelay,
+		"Grace period after which the entries known from a removed remote cluster are dropped, when the drain mode is delayed")
+	flags.StringToString("clustermesh-removal-drain-overrides", def.ClusterMeshRemovalDrainOverrides,
+		"Per-cluster overrides of the removal drain mode, e.g. cluster1=retain")
+	flags.Duration("clustermesh-stale-entries-ttl", def.ClusterMeshStaleEntriesTTL,
+		"Drain the entries imported from a remote cluster that remained disconnected for the given period (0 keeps them until reconnection or removal)")
+	flags.Bool("clustermesh-require-encryption", def.ClusterMeshRequireEncryption,
+		"Refuse to import the nodes of remote clusters that do not advertise transparent encryption support (WireGuard or IPsec)")
+	flags.StringToString("clustermesh-paused-clusters", def.ClusterMeshPausedClusters,
+		"Per-cluster administrative pausing of the connection (e.g. cluster1=true), retaining the previously synchronized entries during planned maintenance windows")
+	flags.Duration("clustermesh-probe-interval", def.ClusterMeshProbeInterval,
+		"Interval between the lightweight probes measuring the round-trip latency towards the kvstore of each connected remote cluster (0 disables the probing)")
+	flags.Bool("clustermesh-shared-etcd-clients", def.ClusterMeshSharedEtcdClients,
+		"Share a single etcd client among the remote clusters whose configuration points to the same etcd cluster")
+	flags.StringToString("clustermesh-connection-priorities", def.ClusterMeshConnectionPriorities,
+		"Per-cluster priorities governing the order in which the connections to the remote clusters are established at startup, higher first (e.g. cluster1=10)")
+	flags.Bool("clustermesh-readiness-gate", def.ClusterMeshReadinessGate,
+		"Report the clustermesh health as degraded until the connections to all remote clusters have been established and the initial synchronization completed")
+}
+
+// backoffConfig are the parameters governing the retry interval of the
+// controller re-establishing the connection to a remote cluster.
+type backoffConfig struct {
+	base   time.Duration
+	max    time.Duration
+	jitter float64
+}
+
+// circuitBreakerConfig are the parameters governing the pausing of the
+// reconnection attempts to a flapping remote cluster.
+type circuitBreakerConfig struct {
+	threshold int
+	window    time.Duration
+	cooldown  time.Duration
+}
+
+// backoffFor resolves the backoff parameters for the given remote cluster,
+// applying a per-cluster override on top of the mesh-wide settings when
+// present. Malformed override elements are skipped with a warning.
+func (c Config) backoffFor(name string) backoffConfig {
+	bc := backoffConfig{
+		base:   c.ClusterMeshReconnectBackoffBase,
+		max:    c.ClusterMeshReconnectBackoffMax,
+		jitter: c.ClusterMeshReconnectBackoffJitter,
+	}
+
+	override, ok := c.ClusterMeshReconnectBackoffOverrides[name]
+	if !ok {
+		return bc
+	}
+
+	scopedLog := log.WithField(fieldClusterName, name)
+	for _, element := range strings.Split(override, ";") {
+		key, value, ok := strings.Cut(element, ":")
+		if !ok {
+			scopedLog.WithField(fieldConfig, element).
+				Warning("Malformed reconnect backoff override element, expected key:value")
+			continue
+		}
+
+		var err error
+		switch key {
+		case "base":
+			bc.base, err = time.ParseDuration(value)
+		case "max":
+			bc.max, err = time.ParseDuration(value)
+		case "jitter":
+			bc.jitter, err = strconv.ParseFloat(value, 64)
+		default:
+			err = fmt.Errorf("unknown key %q", key)
+		}
+		if err != nil {
+			scopedLog.WithError(err).WithField(fieldConfig, element).
+				Warning("Invalid reconnect backoff override element")
+		}
+	}
+
+	return bc
+}
+
+// DrainMode configures the handling of the known entries upon the removal of
+// a remote cluster.
+type DrainMode string
+
+const (
+	// DrainModeImmediate drops the known entries as soon as the remote
+	// cluster is disconnected.
+	DrainModeImmediate DrainMode = "immediate"
+	// DrainModeDelayed drops the known entries after a grace period, so
+	// that a transient removal does not disrupt existing connections.
+	DrainModeDelayed DrainMode = "delayed"
+	// DrainModeRetain keeps the known entries until the agent is restarted.
+	DrainModeRetain DrainMode = "retain"
+)
+
+// drainModeFor resolves the drain mode for the given remote cluster, honoring
+// a per-cluster override when present. An unknown mode falls back to the
+// immediate one with a warning.
+func (c Config) drainModeFor(name string) DrainMode {
+	mode := c.ClusterMeshRemovalDrainMode
+	if override, ok := c.ClusterMeshRemovalDrainOverrides[name]; ok {
+		mode = override
+	}
+
+	switch DrainMode(mode) {
+	case DrainModeDelayed, DrainModeRetain:
+		return DrainMode(mode)
+	case DrainModeImmediate, DrainMode(""):
+		return DrainModeImmediate
+	default:
+		log.WithField(fieldClusterName, name).WithField(fieldConfig, mode).
+			Warning("Unknown removal drain mode, falling back to immediate")
+		return DrainModeImmediate
+	}
+}
+
+// compressionFor resolves the compression algorithm for the given remote
+// cluster, honoring a per-cluster override when present. An unknown algorithm
+// falls back to no compression with a warning. The returned value is empty
+// when compression is disabled.
+func (c Config) compressionFor(name string) string {
+	alg := c.ClusterMeshCompression
+	if override, ok := c.ClusterMeshCompressionOverrides[name]; ok {
+		alg = override
+	}
+
+	switch alg {
+	case compressionGzip:
+		return alg
+	case compressionNone, "":
+		return ""
+	default:
+		log.WithField(fieldClusterName, name).WithField(fieldConfig, alg).
+			Warning("Unknown compression algorithm, disabling compression")
+		return ""
+	}
+}
+
+// pausedFor resolves whether the connection to the given remote cluster
+// shall start administratively paused. Malformed override values are ignored
+// with a warning.
+func (c Config) pausedFor(name string) bool {
+	value, ok := c.ClusterMeshPausedClusters[name]
+	if !ok {
+		return false
+	}
+
+	paused, err := strconv.ParseBool(value)
+	if err != nil {
+		log.WithField(fieldClusterName, name).WithField(fieldConfig, value).
+			Warning("Invalid paused clusters value, expected a boolean, ignoring")
+		return false
+	}
+
+	return paused
+}
+
+// priorityFor resolves the connection priority of the given remote cluster,
+// the higher the earlier it is connected at startup. Malformed values are
+// ignored with a warning.
+func (c Config) priorityFor(name string) int {
+	value, ok := c.ClusterMeshConnectionPriorities[name]
+	if !ok {
+		return 0
+	}
+
+	priority, err := strconv.Atoi(value)
+	if err != nil {
+		log.WithField(fieldClusterName, name).WithField(fieldConfig, value).
+			Warning("Invalid connection priority value, expected an integer, ignoring")
+		return 0
+	}
+
+	return priority
+}
+
+// ServiceFilterFor resolves the filter restricting the services imported from
+// the given remote cluster. A malformed label selector is skipped with a
+// warning, rather than preventing the import of all services.
+func (c Config) ServiceFilterFor(name string) ServiceFilter {
+	var filter ServiceFilter
+
+	if namespaces, ok := c.ClusterMeshServiceFilterNamespaces[name]; ok {
+		filter.namespaces = sets.New(strings.Split(namespaces, ";")...).Delete("")
+	}
+
+	if selector, ok := c.ClusterMeshServiceFilterLabels[name]; ok {
+		parsed, err := k8sLabels.Parse(selector)
+		if err != nil {
+			log.WithError(err).WithField(fieldClusterName, name).WithField(fieldConfig, selector).
+				Warning("Invalid label selector for service import filtering")
+		} else {
+			filter.selector = parsed
+		}
+	}
+
+	return filter
+}
+
+// ResourceScope reports which resource types shall be consumed from a given
+// remote cluster.
+type ResourceScope struct {
+	Nodes      bool
+	Services   bool
+	Identities bool
+	IPCache    bool
+}
+
+// ResourceScopeFor resolves the resource types to be consumed from the given
+// remote cluster. All resource types are imported unless a restriction is
+// configured; unknown resource names are skipped with a warning.
+func (c Config) ResourceScopeFor(name string) ResourceScope {
+	restriction, ok := c.ClusterMeshImportedResources[name]
+	if !ok || restriction == "" {
+		return ResourceScope{Nodes: true, Services: true, Identities: true, IPCache: true}
+	}
+
+	var scope ResourceScope
+	for _, resource := range strings.Split(restriction, ";") {
+		switch resource {
+		case "nodes":
+			scope.Nodes = true
+		case "services":
+			scope.Services = true
+		case "identities":
+			scope.Identities = true
+		case "ipcache":
+			scope.IPCache = true
+		case "":
+		default:
+			log.WithField(fieldClusterName, name).WithField(fieldConfig, resource).
+				Warning("Unknown resource type in imported resources restriction")
+		}
+	}
+
+	return scope
+}
+
+// configPathFor resolves the etcd configuration path for the given remote
+// cluster, honoring a per-cluster override when present. This allows
+// connecting directly to the remote etcd rather than through the local
+// KVStoreMesh cache (or vice versa), regardless of the configuration found
+// in the clustermesh configuration directory. Changes to the overridden
+// configuration file are not tracked, as it lives outside that directory.
+func (c Config) configPathFor(name, path string) string {
+	override, ok := c.ClusterMeshConfigPathOverrides[name]
+	if !ok {
+		return path
+	}
+
+	log.WithField(fieldClusterName, name).WithField(fieldConfig, override).
+		Info("Using overridden etcd configuration path for remote cluster")
+	return override
+}
+
+// etcdOptsFor resolves the per-cluster etcd client option overrides for the
+// given remote cluster. Only the options that makeEtcdOpts inherits from the
+// global kvstore configuration may be overridden; malformed or unknown
+// elements are skipped with a warning.
+func (c Config) etcdOptsFor(name string) map[string]string {
+	override, ok := c.ClusterMeshEtcdOptionOverrides[name]
+	if !ok {
+		return nil
+	}
+
+	scopedLog := log.WithField(fieldClusterName, name)
+	opts := map[string]string{}
+	for _, element := range strings.Split(override, ";") {
+		key, value, ok := strings.Cut(element, ":")
+		if !ok {
+			scopedLog.WithField(fieldConfig, element).
+				Warning("Malformed etcd option override element, expected key:value")
+			continue
+		}
+
+		switch key {
+		case kvstore.EtcdRateLimitOption, kvstore.EtcdMaxInflightOption, kvstore.EtcdListLimitOption,
+			kvstore.EtcdOptionKeepAliveHeartbeat, kvstore.EtcdOptionKeepAliveTimeout:
+			opts[key] = value
+		default:
+			scopedLog.WithField(fieldConfig, element).
+				Warning("Unknown etcd option override key")
+		}
+	}
+
+	return opts
 }
 
 type StatusFunc func() *models.RemoteCluster
 type RemoteClusterCreatorFunc func(name string, status StatusFunc) RemoteCluster
 
+// RemoteClientFactoryFunc returns a new kvstore client providing access to the
+// data of a remote cluster. The returned channel conveys the possible
+// connection error, and is closed upon success. It enables plugging transports
+// alternative to the direct etcd connection (e.g., a gRPC streaming API served
+// by the clustermesh-apiserver), to reduce the etcd client fan-out.
+type RemoteClientFactoryFunc func(ctx context.Context, opts map[string]string, extra *kvstore.ExtraOptions) (kvstore.BackendOperations, chan error)
+
 // Configuration is the configuration that must be provided to
 // NewClusterMesh()
 type Configuration struct {
@@ -47,6 +548,10 @@ type Configuration struct {
 	// NewRemoteCluster is a function returning a new implementation of the remote cluster business logic.
 	NewRemoteCluster RemoteClusterCreatorFunc
 
+	// RemoteClientFactory, if not nil, replaces the creation of the direct
+	// etcd client to connect to remote clusters with an alternative transport.
+	RemoteClientFactory RemoteClientFactoryFunc
+
 	// nodeName is the name of the local node. This is used for logging and metrics
 	NodeName string
 
@@ -69,6 +574,37 @@ type ClusterMesh interface {
 	// NumReadyClusters returns the number of remote clusters to which a connection
 	// has been established
 	NumReadyClusters() int
+	// UseCluster declares that the given remote cluster is currently
+	// referenced (e.g., by a global service or a policy), establishing the
+	// corresponding connection when lazy connections are enabled. It is a
+	// no-op otherwise, as well as for unknown clusters.
+	UseCluster(name string)
+	// DisconnectCluster disconnects the given remote cluster applying the
+	// requested drain mode, regardless of the configured one. It backs
+	// explicit disconnection requests through the API.
+	DisconnectCluster(name string, mode DrainMode)
+	// ConnectCluster connects the given remote cluster at runtime, using
+	// the provided etcd configuration path, without requiring the
+	// corresponding file to be present in the clustermesh configuration
+	// directory. It drives the same insertion path as the directory watcher.
+	ConnectCluster(name, configPath string) error
+	// ValidateCluster performs a dry-run validation of the given clustermesh
+	// configuration, establishing a short-lived connection to the target etcd
+	// cluster, retrieving the advertised cluster configuration and verifying
+	// its compatibility with the local cluster, without registering the
+	// remote cluster.
+	ValidateCluster(ctx context.Context, name, configPath string) (types.CiliumClusterConfig, error)
+	// PauseCluster temporarily stops the connection to the given remote
+	// cluster, retaining the known entries. It is a no-op for unknown
+	// clusters.
+	PauseCluster(name string)
+	// ResumeCluster re-establishes the connection to a previously paused
+	// remote cluster. It is a no-op for unknown and non-paused clusters.
+	ResumeCluster(name string)
+	// RegisterEventSink registers the given sink to receive the remote
+	// cluster lifecycle events. Events emitted before the registration are
+	// not replayed.
+	RegisterEventSink(sink LifecycleEventSink)
 }
 
 // clusterMesh is a cache of multiple remote clusters
@@ -79,19 +615,40 @@ type clusterMesh struct {
 	mutex         lock.RWMutex
 	clusters      map[string]*remoteCluster
 	configWatcher *configDirectoryWatcher
+
+	// pendingDrains are the timers tracking the delayed drain of the
+	// entries known from removed remote clusters, keyed by cluster name.
+	pendingDrains map[string]*time.Timer
+
+	// clientPool allows sharing the etcd clients among the remote clusters
+	// pointing to the same etcd cluster, when enabled, and is nil otherwise.
+	clientPool *etcdClientPool
+
+	// eventSinks are the registered receivers of the remote cluster
+	// lifecycle events. They are protected by a dedicated mutex, as events
+	// may be emitted while the main mutex is held.
+	eventSinksMutex lock.RWMutex
+	eventSinks      []LifecycleEventSink
 }
 
 // NewClusterMesh creates a new remote cluster cache based on the
 // provided configuration
 func NewClusterMesh(c Configuration) ClusterMesh {
-	return &clusterMesh{
-		conf:     c,
-		clusters: map[string]*remoteCluster{},
+	cm := &clusterMesh{
+		conf:          c,
+		clusters:      map[string]*remoteCluster{},
+		pendingDrains: map[string]*time.Timer{},
 	}
+
+	if c.ClusterMeshSharedEtcdClients {
+		cm.clientPool = newEtcdClientPool()
+	}
+
+	return cm
 }
 
 func (cm *clusterMesh) Start(cell.HookContext) error {
-	w, err := createConfigDirectoryWatcher(cm.conf.ClusterMeshConfig, cm)
+	w, err := createConfigDirectoryWatcher(cm.conf.ClusterMeshConfig, cm, cm.conf.priorityFor)
 	if err != nil {
 		return fmt.Errorf("unable to create config directory watcher: %w", err)
 	}
@@ -120,15 +677,43 @@ func (cm *clusterMesh) Stop(cell.HookContext) error {
 		delete(cm.clusters, name)
 	}
 
+	// Stop the pending drains, as the entries shall be retained on restart.
+	for name, timer := range cm.pendingDrains {
+		timer.Stop()
+		delete(cm.pendingDrains, name)
+	}
+
 	return nil
 }
 
 func (cm *clusterMesh) newRemoteCluster(name, path string) *remoteCluster {
+	compression := cm.conf.compressionFor(name)
+
 	rc := &remoteCluster{
 		name:                         name,
 		configPath:                   path,
+		compression:                  compression,
 		clusterSizeDependantInterval: cm.conf.ClusterSizeDependantInterval,
 		serviceIPGetter:              cm.conf.ServiceIPGetter,
+		remoteClientFactory:          cm.conf.RemoteClientFactory,
+		backoff:                      cm.conf.backoffFor(name),
+		etcdOptOverrides:             cm.conf.etcdOptsFor(name),
+		syncDeadline:                 cm.conf.ClusterMeshDegradedSyncTimeout,
+		lazy:                         cm.conf.ClusterMeshLazyConnections,
+		idleTimeout:                  cm.conf.ClusterMeshLazyIdleTimeout,
+		staleTTL:                     cm.conf.ClusterMeshStaleEntriesTTL,
+		requireEncryption:            cm.conf.ClusterMeshRequireEncryption,
+		clientPool:                   cm.clientPool,
+		probeInterval:                cm.conf.ClusterMeshProbeInterval,
+		paused:                       cm.conf.pausedFor(name),
+		emitEvent: func(typ LifecycleEventType, message string) {
+			cm.emitLifecycleEvent(name, typ, message)
+		},
+		circuitBreaker: circuitBreakerConfig{
+			threshold: cm.conf.ClusterMeshCircuitBreakerThreshold,
+			window:    cm.conf.ClusterMeshCircuitBreakerWindow,
+			cooldown:  cm.conf.ClusterMeshCircuitBreakerCooldown,
+		},
 
 		changed:     make(chan bool, configNotificationsChannelSize),
 		controllers: controller.NewManager(),
@@ -138,6 +723,19 @@ func (cm *clusterMesh) newRemoteCluster(name, path string) *remoteCluster {
 		metricLastFailureTimestamp: cm.conf.Metrics.LastFailureTimestamp.WithLabelValues(cm.conf.ClusterInfo.Name, cm.conf.NodeName, name),
 		metricReadinessStatus:      cm.conf.Metrics.ReadinessStatus.WithLabelValues(cm.conf.ClusterInfo.Name, cm.conf.NodeName, name),
 		metricTotalFailures:        cm.conf.Metrics.TotalFailures.WithLabelValues(cm.conf.ClusterInfo.Name, cm.conf.NodeName, name),
+		metricDegradedStatus:       cm.conf.Metrics.DegradedStatus.WithLabelValues(cm.conf.ClusterInfo.Name, cm.conf.NodeName, name),
+		metricCircuitBreakerOpen:   cm.conf.Metrics.CircuitBreakerOpen.WithLabelValues(cm.conf.ClusterInfo.Name, cm.conf.NodeName, name),
+		metricConfigConflict:       cm.conf.Metrics.ConfigConflict.WithLabelValues(cm.conf.ClusterInfo.Name, cm.conf.NodeName, name),
+		metricRejectedStatus:       cm.conf.Metrics.RejectedStatus.WithLabelValues(cm.conf.ClusterInfo.Name, cm.conf.NodeName, name),
+		metricProbeLatency:         cm.conf.Metrics.ProbeLatency.WithLabelValues(cm.conf.ClusterInfo.Name, cm.conf.NodeName, name),
+
+		trafficMetrics: &streamMetrics{
+			processingLatency: cm.conf.Metrics.WatchEventProcessingLatency.WithLabelValues(cm.conf.ClusterInfo.Name, cm.conf.NodeName, name),
+			bytesReceived:     cm.conf.Metrics.BytesReceived.WithLabelValues(cm.conf.ClusterInfo.Name, cm.conf.NodeName, name, cmp.Or(compression, compressionNone)),
+			events: func(resource string) prometheus.Counter {
+				return cm.conf.Metrics.WatchEvents.WithLabelValues(cm.conf.ClusterInfo.Name, cm.conf.NodeName, name, resource)
+			},
+		},
 	}
 
 	rc.RemoteCluster = cm.conf.NewRemoteCluster(name, rc.status)
@@ -157,9 +755,19 @@ func (cm *clusterMesh) add(name, path string) {
 
 	inserted := false
 	cm.mutex.Lock()
+	if timer, ok := cm.pendingDrains[name]; ok {
+		if timer.Stop() {
+			log.WithField(fieldClusterName, name).Info("Cancelling pending drain of re-connected remote cluster")
+		}
+		delete(cm.pendingDrains, name)
+	}
+
 	cluster, ok := cm.clusters[name]
 	if !ok {
-		cluster = cm.newRemoteCluster(name, path)
+		cluster = cm.newRemoteCluster(name, cm.conf.configPathFor(name, path))
+		if limit := cm.conf.ClusterInfo.MaxConnectedClusters; limit > 0 && uint32(cm.numAdmittedLocked()) >= limit {
+			cluster.setRejected(true)
+		}
 		cm.clusters[name] = cluster
 		inserted = true
 	}
@@ -176,17 +784,108 @@ func (cm *clusterMesh) add(name, path string) {
 }
 
 func (cm *clusterMesh) remove(name string) {
+	cm.removeWithDrain(name, cm.conf.drainModeFor(name))
+}
+
+func (cm *clusterMesh) removeWithDrain(name string, mode DrainMode) {
 	cm.mutex.Lock()
 	if cluster, ok := cm.clusters[name]; ok {
-		cluster.onRemove()
+		admitted := !cluster.isRejected()
+
+		switch mode {
+		case DrainModeRetain:
+			cluster.onStop()
+			cluster.logger.Info("Remote cluster disconnected, retaining entries until agent restart")
+		case DrainModeDelayed:
+			cluster.onStop()
+			cluster.logger.WithField(logfields.Duration, cm.conf.ClusterMeshRemovalDrainDelay).
+				Info("Remote cluster disconnected, draining entries after grace period")
+			cm.pendingDrains[name] = time.AfterFunc(cm.conf.ClusterMeshRemovalDrainDelay, cluster.drain)
+		default:
+			cluster.onRemove()
+		}
+
 		delete(cm.clusters, name)
 		cm.conf.Metrics.TotalRemoteClusters.WithLabelValues(cm.conf.ClusterInfo.Name, cm.conf.NodeName).Set(float64(len(cm.clusters)))
+
+		if admitted {
+			cm.admitPendingLocked()
+		}
 	}
 	cm.mutex.Unlock()
 
 	log.WithField(fieldClusterName, name).Debug("Remote cluster configuration removed")
 }
 
+// DisconnectCluster disconnects the given remote cluster applying the
+// requested drain mode, regardless of the configured one.
+func (cm *clusterMesh) DisconnectCluster(name string, mode DrainMode) {
+	cm.removeWithDrain(name, mode)
+}
+
+// ConnectCluster connects the given remote cluster at runtime, using the
+// provided etcd configuration path.
+func (cm *clusterMesh) ConnectCluster(name, configPath string) error {
+	if name == cm.conf.ClusterInfo.Name {
+		return fmt.Errorf("cluster name %q matches that of the local cluster", name)
+	}
+
+	if err := types.ValidateClusterName(name); err != nil {
+		return fmt.Errorf("invalid cluster name: %w", err)
+	}
+
+	cm.add(name, configPath)
+	return nil
+}
+
+// PauseCluster temporarily stops the connection to the given remote cluster,
+// retaining the known entries.
+func (cm *clusterMesh) PauseCluster(name string) {
+	cm.mutex.RLock()
+	cluster, ok := cm.clusters[name]
+	cm.mutex.RUnlock()
+
+	if ok {
+		cluster.pause()
+	}
+}
+
+// ResumeCluster re-establishes the connection to a previously paused remote
+// cluster.
+func (cm *clusterMesh) ResumeCluster(name string) {
+	cm.mutex.RLock()
+	cluster, ok := cm.clusters[name]
+	cm.mutex.RUnlock()
+
+	if ok {
+		cluster.resume()
+	}
+}
+
+// numAdmittedLocked returns the number of remote clusters that have not been
+// rejected due to the maximum connected clusters limit.
+func (cm *clusterMesh) numAdmittedLocked() int {
+	admitted := 0
+	for _, cluster := range cm.clusters {
+		if !cluster.isRejected() {
+			admitted++
+		}
+	}
+
+	return admitted
+}
+
+// admitPendingLocked admits one of the rejected remote clusters, if any, once
+// the removal of an admitted cluster made capacity available.
+func (cm *clusterMesh) admitPendingLocked() {
+	for _, cluster := range cm.clusters {
+		if cluster.isRejected() {
+			cluster.admit()
+			return
+		}
+	}
+}
+
 // NumReadyClusters returns the number of remote clusters to which a connection
 // has been established
 func (cm *clusterMesh) NumReadyClusters() int {
@@ -203,6 +902,18 @@ func (cm *clusterMesh) NumReadyClusters() int {
 	return nready
 }
 
+// UseCluster declares that the given remote cluster is currently referenced,
+// establishing the corresponding connection when lazy connections are enabled.
+func (cm *clusterMesh) UseCluster(name string) {
+	cm.mutex.RLock()
+	cluster, ok := cm.clusters[name]
+	cm.mutex.RUnlock()
+
+	if ok {
+		cluster.use()
+	}
+}
+
 func (cm *clusterMesh) ForEachRemoteCluster(fn func(RemoteCluster) error) error {
 	cm.mutex.RLock()
 	defer cm.mutex.RUnlock()
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package common
+
+import (
+	"compress/gzip"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc/encoding"
+)
+
+const (
+	// compressionNone disables payload compression.
+	compressionNone = "none"
+
+	// compressionGzip enables gzip payload compression, trading CPU for
+	// bandwidth on WAN links.
+	compressionGzip = "gzip"
+)
+
+func init() {
+	encoding.RegisterCompressor(&gzipCompressor{})
+}
+
+// gzipCompressor implements the gRPC compressor interface backed by
+// compress/gzip, to optionally compress the payloads exchanged with the etcd
+// cluster of a remote cluster. The writers are pooled to amortize the
+// allocation cost on the hot path.
+type gzipCompressor struct {
+	writers sync.Pool
+}
+
+func (c *gzipCompressor) Name() string {
+	return compressionGzip
+}
+
+func (c *gzipCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	gz, ok := c.writers.Get().(*gzip.Writer)
+	if !ok {
+		gz = gzip.NewWriter(w)
+	} else {
+		gz.Reset(w)
+	}
+	return &pooledGzipWriter{Writer: gz, pool: &c.writers}, nil
+}
+
+func (c *gzipCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	return gzip.NewReader(r)
+}
+
+// pooledGzipWriter returns the wrapped gzip writer to the pool upon closure.
+type pooledGzipWriter struct {
+	*gzip.Writer
+	pool *sync.Pool
+}
+
+func (w *pooledGzipWriter) Close() error {
+	defer w.pool.Put(w.Writer)
+	return w.Writer.Close()
+}
@@ -30,9 +30,13 @@ type configDirectoryWatcher struct {
 	path      string
 	tracked   map[string]fhash
 	stop      chan struct{}
+
+	// priority, if not nil, returns the connection priority of the given
+	// cluster, governing the processing order of the initial scan.
+	priority func(cluster string) int
 }
 
-func createConfigDirectoryWatcher(path string, lifecycle clusterLifecycle) (*configDirectoryWatcher, error) {
+func createConfigDirectoryWatcher(path string, lifecycle clusterLifecycle, priority func(cluster string) int) (*configDirectoryWatcher, error) {
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
 		return nil, err
@@ -49,6 +53,7 @@ func createConfigDirectoryWatcher(path string, lifecycle clusterLifecycle) (*con
 		tracked:   map[string]fhash{},
 		lifecycle: lifecycle,
 		stop:      make(chan struct{}),
+		priority:  priority,
 	}, nil
 }
 
@@ -139,13 +144,23 @@ func (cdw *configDirectoryWatcher) watch() error {
 		return err
 	}
 
+	names := make([]string, 0, len(files))
 	for _, f := range files {
-		if f.IsDir() {
-			continue
+		if !f.IsDir() {
+			names = append(names, f.Name())
 		}
+	}
+
+	// Process the configurations in priority order, so that the connections
+	// to the most critical remote clusters are established first.
+	if cdw.priority != nil {
+		slices.SortStableFunc(names, func(a, b string) int {
+			return cdw.priority(b) - cdw.priority(a)
+		})
+	}
 
-		absolutePath := filepath.Join(cdw.path, f.Name())
-		cdw.handle(absolutePath)
+	for _, name := range names {
+		cdw.handle(filepath.Join(cdw.path, name))
 	}
 
 	go cdw.loop()
@@ -33,11 +33,13 @@ var (
 
 type fakeRemoteCluster struct{}
 
-func (*fakeRemoteCluster) Run(_ context.Context, _ kvstore.BackendOperations, _ types.CiliumClusterConfig, ready chan<- error) {
+func (*fakeRemoteCluster) Run(ctx context.Context, _ kvstore.BackendOperations, _ types.CiliumClusterConfig, ready chan<- error) {
 	close(ready)
+	<-ctx.Done()
 }
-func (*fakeRemoteCluster) Stop()   {}
-func (*fakeRemoteCluster) Remove() {}
+func (*fakeRemoteCluster) WaitForSync(context.Context) error { return nil }
+func (*fakeRemoteCluster) Stop()                             {}
+func (*fakeRemoteCluster) Remove()                           {}
 
 func writeFile(t *testing.T, name, content string) {
 	t.Helper()
@@ -183,6 +185,31 @@ func TestWatchConfigDirectory(t *testing.T) {
 	require.ElementsMatch(t, wl, []string{baseDir})
 }
 
+type recordingLifecycle struct {
+	added []string
+}
+
+func (r *recordingLifecycle) add(name, _ string) { r.added = append(r.added, name) }
+func (r *recordingLifecycle) remove(string)      {}
+
+func TestWatchConfigDirectoryPriority(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"cluster1", "cluster2", "cluster3"} {
+		writeFile(t, path.Join(dir, name), content1)
+	}
+
+	priorities := map[string]int{"cluster2": 10, "cluster3": 5}
+	rec := &recordingLifecycle{}
+
+	cdw, err := createConfigDirectoryWatcher(dir, rec, func(cluster string) int { return priorities[cluster] })
+	require.NoError(t, err)
+	defer cdw.close()
+
+	// The initial scan shall process the configurations in priority order.
+	require.NoError(t, cdw.watch())
+	require.Equal(t, []string{"cluster2", "cluster3", "cluster1"}, rec.added)
+}
+
 func TestIsEtcdConfigFile(t *testing.T) {
 	dir := t.TempDir()
 
@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package common
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// endpointScoreDecay is the period after which past failures of an etcd
+// endpoint are forgotten, so that a previously unhealthy endpoint is given
+// a chance again.
+const endpointScoreDecay = 10 * time.Minute
+
+// endpointSelector implements client-side load balancing across the etcd
+// endpoints of a remote cluster, tracking a health score per endpoint based
+// on the observed connection failures. Healthier endpoints are preferred on
+// reconnection, implementing automatic failover when the currently selected
+// endpoint misbehaves, rather than relying solely on the etcd client
+// failover logic.
+type endpointSelector struct {
+	mutex lock.Mutex
+
+	// scores tracks the failures recently observed per endpoint.
+	scores map[string]*endpointScore
+
+	// preferred is the endpoint that was dialed first by the last
+	// connection attempt, and to which subsequent failures and successes
+	// are attributed.
+	preferred string
+}
+
+type endpointScore struct {
+	failures    int
+	lastFailure time.Time
+}
+
+// order returns the given endpoints sorted from healthiest to unhealthiest,
+// shuffling the ones with an equal score to spread the load across agents.
+// The first returned endpoint is recorded as the preferred one.
+func (es *endpointSelector) order(endpoints []string) []string {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	ordered := slices.Clone(endpoints)
+	rand.Shuffle(len(ordered), func(i, j int) {
+		ordered[i], ordered[j] = ordered[j], ordered[i]
+	})
+
+	slices.SortStableFunc(ordered, func(a, b string) int {
+		return es.scoreLocked(a) - es.scoreLocked(b)
+	})
+
+	if len(ordered) > 0 {
+		es.preferred = ordered[0]
+	}
+
+	return ordered
+}
+
+// scoreLocked returns the health score of the given endpoint, the lower the
+// healthier. Failures older than the decay period are forgotten.
+func (es *endpointSelector) scoreLocked(endpoint string) int {
+	score, ok := es.scores[endpoint]
+	if !ok {
+		return 0
+	}
+
+	if time.Since(score.lastFailure) > endpointScoreDecay {
+		delete(es.scores, endpoint)
+		return 0
+	}
+
+	return score.failures
+}
+
+// observeFailure attributes a connection failure to the preferred endpoint,
+// so that a different one is tried first on the next reconnection.
+func (es *endpointSelector) observeFailure() {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	if es.preferred == "" {
+		return
+	}
+
+	if es.scores == nil {
+		es.scores = map[string]*endpointScore{}
+	}
+
+	score, ok := es.scores[es.preferred]
+	if !ok {
+		score = &endpointScore{}
+		es.scores[es.preferred] = score
+	}
+
+	score.failures++
+	score.lastFailure = time.Now()
+}
+
+// observeSuccess clears the past failures of the preferred endpoint, once
+// the connection through it has been successfully established.
+func (es *endpointSelector) observeSuccess() {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	delete(es.scores, es.preferred)
+}
+
+// ipFamily represents the address family of an etcd endpoint.
+type ipFamily int
+
+const (
+	// familyUnknown denotes endpoints expressed as DNS names, whose
+	// address family is only determined at dial time.
+	familyUnknown ipFamily = iota
+	familyIPv4
+	familyIPv6
+)
+
+// endpointIPFamily returns the address family of the given etcd endpoint,
+// or familyUnknown when it is expressed as a DNS name.
+func endpointIPFamily(endpoint string) ipFamily {
+	host := endpoint
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+
+	addr, err := netip.ParseAddr(host)
+	switch {
+	case err != nil:
+		return familyUnknown
+	case addr.Is4() || addr.Is4In6():
+		return familyIPv4
+	default:
+		return familyIPv6
+	}
+}
+
+// selectEndpointFamilies filters the given etcd endpoints based on the
+// address families enabled in the local cluster, so that IPv6-only remote
+// clusters are supported when IPv6 is enabled, and a clear error is returned
+// when all endpoints require a disabled family, rather than failing the dial
+// with a generic timeout. Endpoints expressed as DNS names are always
+// retained, as their address family is only determined at dial time.
+func selectEndpointFamilies(endpoints []string, ipv4, ipv6 bool) ([]string, error) {
+	var seenV4, seenV6 bool
+
+	usable := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		switch endpointIPFamily(endpoint) {
+		case familyIPv4:
+			seenV4 = true
+			if ipv4 {
+				usable = append(usable, endpoint)
+			}
+		case familyIPv6:
+			seenV6 = true
+			if ipv6 {
+				usable = append(usable, endpoint)
+			}
+		default:
+			usable = append(usable, endpoint)
+		}
+	}
+
+	if len(usable) == 0 {
+		switch {
+		case seenV4 && seenV6:
+			return nil, errors.New("etcd endpoints require IPv4 or IPv6, but both are disabled in the local cluster")
+		case seenV6:
+			return nil, errors.New("etcd endpoints are expressed as IPv6 addresses, but IPv6 is disabled in the local cluster")
+		case seenV4:
+			return nil, errors.New("etcd endpoints are expressed as IPv4 addresses, but IPv4 is disabled in the local cluster")
+		default:
+			return nil, errors.New("no etcd endpoints configured")
+		}
+	}
+
+	return usable, nil
+}
+
+// interleaveFamilies alternates the endpoints of the two address families,
+// preserving their relative order, so that consecutive connection attempts
+// do not insist on a possibly broken family when both are configured, in the
+// spirit of happy-eyeballs. The first family is that of the healthiest
+// endpoint. The order is left untouched when a single family is present, as
+// well as in the presence of DNS names, which may resolve to either family.
+func interleaveFamilies(endpoints []string) []string {
+	var v4, v6 []string
+	for _, endpoint := range endpoints {
+		switch endpointIPFamily(endpoint) {
+		case familyIPv4:
+			v4 = append(v4, endpoint)
+		case familyIPv6:
+			v6 = append(v6, endpoint)
+		default:
+			return endpoints
+		}
+	}
+
+	if len(v4) == 0 || len(v6) == 0 {
+		return endpoints
+	}
+
+	next, other := v4, v6
+	if endpointIPFamily(endpoints[0]) == familyIPv6 {
+		next, other = v6, v4
+	}
+
+	interleaved := make([]string, 0, len(endpoints))
+	for len(next) > 0 || len(other) > 0 {
+		if len(next) > 0 {
+			interleaved = append(interleaved, next[0])
+			next = next[1:]
+		}
+		next, other = other, next
+	}
+
+	return interleaved
+}
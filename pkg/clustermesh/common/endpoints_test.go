@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointIPFamily(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		expected ipFamily
+	}{
+		{"https://cluster1.cilium-etcd.cilium.svc:2379", familyUnknown},
+		{"cluster1.cilium-etcd.cilium.svc:2379", familyUnknown},
+		{"https://192.0.2.1:2379", familyIPv4},
+		{"192.0.2.1:2379", familyIPv4},
+		{"https://[2001:db8::1]:2379", familyIPv6},
+		{"[2001:db8::1]:2379", familyIPv6},
+		{"https://[::ffff:192.0.2.1]:2379", familyIPv4},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, endpointIPFamily(tt.endpoint), "endpoint: %s", tt.endpoint)
+	}
+}
+
+func TestSelectEndpointFamilies(t *testing.T) {
+	v4 := "https://192.0.2.1:2379"
+	v6 := "https://[2001:db8::1]:2379"
+	dns := "https://cluster1.cilium-etcd.cilium.svc:2379"
+
+	tests := []struct {
+		name       string
+		endpoints  []string
+		ipv4, ipv6 bool
+		expected   []string
+		err        string
+	}{
+		{
+			name:      "dual-stack endpoints, dual-stack cluster",
+			endpoints: []string{v4, v6}, ipv4: true, ipv6: true,
+			expected: []string{v4, v6},
+		},
+		{
+			name:      "dual-stack endpoints, IPv4-only cluster",
+			endpoints: []string{v4, v6}, ipv4: true,
+			expected: []string{v4},
+		},
+		{
+			name:      "IPv6-only endpoints, IPv6-only cluster",
+			endpoints: []string{v6}, ipv6: true,
+			expected: []string{v6},
+		},
+		{
+			name:      "IPv6-only endpoints, IPv4-only cluster",
+			endpoints: []string{v6}, ipv4: true,
+			err: "expressed as IPv6 addresses, but IPv6 is disabled",
+		},
+		{
+			name:      "IPv4-only endpoints, IPv6-only cluster",
+			endpoints: []string{v4}, ipv6: true,
+			err: "expressed as IPv4 addresses, but IPv4 is disabled",
+		},
+		{
+			name:      "DNS endpoints are always retained",
+			endpoints: []string{dns, v6}, ipv4: true,
+			expected: []string{dns},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := selectEndpointFamilies(tt.endpoints, tt.ipv4, tt.ipv6)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, selected)
+		})
+	}
+}
+
+func TestInterleaveFamilies(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoints []string
+		expected  []string
+	}{
+		{
+			name:      "single family is left untouched",
+			endpoints: []string{"https://192.0.2.1:2379", "https://192.0.2.2:2379"},
+			expected:  []string{"https://192.0.2.1:2379", "https://192.0.2.2:2379"},
+		},
+		{
+			name:      "DNS names disable interleaving",
+			endpoints: []string{"https://foo.bar:2379", "https://192.0.2.1:2379", "https://[2001:db8::1]:2379"},
+			expected:  []string{"https://foo.bar:2379", "https://192.0.2.1:2379", "https://[2001:db8::1]:2379"},
+		},
+		{
+			name: "families are alternated starting from the first endpoint",
+			endpoints: []string{
+				"https://[2001:db8::1]:2379", "https://[2001:db8::2]:2379",
+				"https://192.0.2.1:2379", "https://192.0.2.2:2379",
+			},
+			expected: []string{
+				"https://[2001:db8::1]:2379", "https://192.0.2.1:2379",
+				"https://[2001:db8::2]:2379", "https://192.0.2.2:2379",
+			},
+		},
+		{
+			name: "leftover endpoints of the longer family are appended",
+			endpoints: []string{
+				"https://192.0.2.1:2379", "https://192.0.2.2:2379",
+				"https://192.0.2.3:2379", "https://[2001:db8::1]:2379",
+			},
+			expected: []string{
+				"https://192.0.2.1:2379", "https://[2001:db8::1]:2379",
+				"https://192.0.2.2:2379", "https://192.0.2.3:2379",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, interleaveFamilies(tt.endpoints))
+		})
+	}
+}
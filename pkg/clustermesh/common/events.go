@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package common
+
+import (
+	"time"
+)
+
+// LifecycleEventType is the type of a remote cluster lifecycle event.
+type LifecycleEventType string
+
+const (
+	// LifecycleEventConnected is emitted when the connection to the etcd
+	// cluster of a remote cluster has been successfully established.
+	LifecycleEventConnected LifecycleEventType = "connected"
+
+	// LifecycleEventConfigChanged is emitted when the configuration of a
+	// remote cluster changed, triggering the re-creation of the connection.
+	LifecycleEventConfigChanged LifecycleEventType = "config-changed"
+
+	// LifecycleEventFailure is emitted when the connection to a remote
+	// cluster could not be established, or an established connection
+	// failed the status checks.
+	LifecycleEventFailure LifecycleEventType = "failure"
+
+	// LifecycleEventSyncCompleted is emitted when the initial synchronization
+	// of all resource types from a remote cluster has completed.
+	LifecycleEventSyncCompleted LifecycleEventType = "sync-completed"
+
+	// LifecycleEventDrained is emitted when the entries imported from a
+	// remote cluster have been drained.
+	LifecycleEventDrained LifecycleEventType = "drained"
+)
+
+// LifecycleEvent is a structured event describing a change in the lifecycle
+// of the connection to a remote cluster.
+type LifecycleEvent struct {
+	// Cluster is the name of the remote cluster the event refers to.
+	Cluster string
+
+	// Type is the type of the event.
+	Type LifecycleEventType
+
+	// Timestamp is the time at which the event occurred.
+	Timestamp time.Time
+
+	// Message is an optional human readable description of the event.
+	Message string
+}
+
+// LifecycleEventSink is the interface to be implemented to receive the remote
+// cluster lifecycle events, e.g., to forward them to Hubble, Kubernetes
+// Events, or external audit systems. Implementations shall not block, as the
+// events are emitted synchronously from the connection management logic.
+type LifecycleEventSink interface {
+	HandleLifecycleEvent(event LifecycleEvent)
+}
+
+// RegisterEventSink registers the given sink to receive the remote cluster
+// lifecycle events. Events emitted before the registration are not replayed.
+func (cm *clusterMesh) RegisterEventSink(sink LifecycleEventSink) {
+	cm.eventSinksMutex.Lock()
+	cm.eventSinks = append(cm.eventSinks, sink)
+	cm.eventSinksMutex.Unlock()
+}
+
+// emitLifecycleEvent forwards the given event to all registered sinks.
+func (cm *clusterMesh) emitLifecycleEvent(cluster string, typ LifecycleEventType, message string) {
+	cm.eventSinksMutex.RLock()
+	sinks := cm.eventSinks
+	cm.eventSinksMutex.RUnlock()
+
+	event := LifecycleEvent{
+		Cluster:   cluster,
+		Type:      typ,
+		Timestamp: time.Now(),
+		Message:   message,
+	}
+
+	for _, sink := range sinks {
+		sink.HandleLifecycleEvent(event)
+	}
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path"
+	"time"
+
+	"github.com/cilium/cilium/pkg/clustermesh/types"
+	"github.com/cilium/cilium/pkg/kvstore"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// errFakeKvstorePartitioned is the error returned by the fake kvstore when
+// the simulated network partition is active.
+var errFakeKvstorePartitioned = errors.New("fake kvstore partitioned")
+
+// fakeKvstoreFactory creates fake kvstore clients backing the connections to
+// remote clusters in tests, with programmable partitions, delayed watches and
+// forced status-check errors, so that the reconnection and drain logic gets
+// deterministic coverage without a real etcd instance.
+type fakeKvstoreFactory struct {
+	mutex       lock.Mutex
+	partitioned bool
+	watchDelay  time.Duration
+	configs     map[string]types.CiliumClusterConfig
+	clients     []*fakeKvstoreClient
+}
+
+func newFakeKvstoreFactory() *fakeKvstoreFactory {
+	return &fakeKvstoreFactory{
+		configs: make(map[string]types.CiliumClusterConfig),
+	}
+}
+
+// setClusterConfig configures the cluster configuration advertised by the
+// fake kvstore for the given remote cluster.
+func (f *fakeKvstoreFactory) setClusterConfig(name string, config types.CiliumClusterConfig) {
+	f.mutex.Lock()
+	f.configs[name] = config
+	f.mutex.Unlock()
+}
+
+// partition simulates a network partition, causing subsequent connection
+// attempts and operations to fail until healed. Established connections are
+// not torn down automatically; use failStatusChecks to break them.
+func (f *fakeKvstoreFactory) partition() {
+	f.mutex.Lock()
+	f.partitioned = true
+	f.mutex.Unlock()
+}
+
+// heal terminates the simulated network partition.
+func (f *fakeKvstoreFactory) heal() {
+	f.mutex.Lock()
+	f.partitioned = false
+	f.mutex.Unlock()
+}
+
+// setWatchDelay delays the emission of the initial list done event of the
+// watches started against subsequently created clients.
+func (f *fakeKvstoreFactory) setWatchDelay(delay time.Duration) {
+	f.mutex.Lock()
+	f.watchDelay = delay
+	f.mutex.Unlock()
+}
+
+// failStatusChecks injects a status-check failure into all open clients,
+// breaking the corresponding established connections.
+func (f *fakeKvstoreFactory) failStatusChecks(err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, client := range f.clients {
+		if client.closed {
+			continue
+		}
+
+		select {
+		case client.statusErrors <- err:
+		default:
+		}
+	}
+}
+
+// NewClient implements RemoteClientFactoryFunc.
+func (f *fakeKvstoreFactory) NewClient(ctx context.Context, opts map[string]string, extra *kvstore.ExtraOptions) (kvstore.BackendOperations, chan error) {
+	errch := make(chan error, 1)
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.partitioned {
+		errch <- errFakeKvstorePartitioned
+		close(errch)
+		return nil, errch
+	}
+
+	client := &fakeKvstoreClient{
+		factory:      f,
+		statusErrors: make(chan error, 1),
+	}
+	f.clients = append(f.clients, client)
+
+	close(errch)
+	return client, errch
+}
+
+// fakeKvstoreClient is a fake kvstore client created by fakeKvstoreFactory.
+// It implements the subset of kvstore.BackendOperations exercised by the
+// remote cluster connection logic, and panics on any other operation.
+type fakeKvstoreClient struct {
+	kvstore.BackendOperations
+
+	factory      *fakeKvstoreFactory
+	statusErrors chan error
+
+	// closed is protected by factory.mutex.
+	closed bool
+}
+
+func (fc *fakeKvstoreClient) Get(ctx context.Context, key string) ([]byte, error) {
+	fc.factory.mutex.Lock()
+	defer fc.factory.mutex.Unlock()
+
+	if fc.factory.partitioned || fc.closed {
+		return nil, errFakeKvstorePartitioned
+	}
+
+	// The cluster configuration is the only key retrieved by the connection logic.
+	if config, ok := fc.factory.configs[path.Base(key)]; ok {
+		return json.Marshal(config)
+	}
+
+	return nil, nil
+}
+
+func (fc *fakeKvstoreClient) ListAndWatch(ctx context.Context, prefix string, chanSize int) *kvstore.Watcher {
+	fc.factory.mutex.Lock()
+	delay := fc.factory.watchDelay
+	fc.factory.mutex.Unlock()
+
+	events := make(kvstore.EventChan, chanSize)
+	go func() {
+		defer close(events)
+
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case events <- kvstore.KeyValueEvent{Typ: kvstore.EventTypeListDone}:
+		case <-ctx.Done():
+			return
+		}
+
+		<-ctx.Done()
+	}()
+
+	return &kvstore.Watcher{Events: events}
+}
+
+func (fc *fakeKvstoreClient) StatusCheckErrors() <-chan error {
+	return fc.statusErrors
+}
+
+func (fc *fakeKvstoreClient) Close(context.Context) {
+	fc.factory.mutex.Lock()
+	defer fc.factory.mutex.Unlock()
+
+	if !fc.closed {
+		fc.closed = true
+		close(fc.statusErrors)
+	}
+}
@@ -7,8 +7,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync/atomic"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"go.etcd.io/etcd/api/v3/etcdserverpb"
 	"google.golang.org/grpc"
 )
@@ -18,20 +21,70 @@ var (
 	ErrEtcdInvalidResponse = errors.New("received an invalid etcd response")
 )
 
+// streamMetrics collects the per-remote-cluster traffic metrics observed by
+// the client interceptors. It may be nil when metrics are not collected.
+type streamMetrics struct {
+	// processingLatency observes the time spent processing each received
+	// watch response, measured between subsequent receptions.
+	processingLatency prometheus.Observer
+	// bytesReceived counts the bytes received from the remote cluster.
+	bytesReceived prometheus.Counter
+	// events returns the counter tracking the number of watch events
+	// received from the remote cluster for the given resource type.
+	events func(resource string) prometheus.Counter
+}
+
+// observe accounts the given received message, in terms of transferred bytes
+// and watch events per resource type.
+func (sm *streamMetrics) observe(m any) {
+	if sm == nil {
+		return
+	}
+
+	if msg, ok := m.(interface{ Size() int }); ok {
+		sm.bytesReceived.Add(float64(msg.Size()))
+	}
+
+	if watch, ok := m.(*etcdserverpb.WatchResponse); ok {
+		for _, event := range watch.Events {
+			if event.Kv == nil {
+				continue
+			}
+
+			sm.events(watchEventResource(string(event.Kv.Key))).Inc()
+		}
+	}
+}
+
+// watchEventResource maps the etcd key of a watch event to the corresponding
+// resource type (e.g., nodes, services, identities, ip), for metrics purposes.
+func watchEventResource(key string) string {
+	for _, prefix := range []string{"cilium/state/", "cilium/cache/"} {
+		if rest, ok := strings.CutPrefix(key, prefix); ok {
+			if resource, _, found := strings.Cut(rest, "/"); found {
+				return resource
+			}
+		}
+	}
+
+	return "other"
+}
+
 // newUnaryInterceptor returns a new unary client interceptor that validates the
-// cluster ID of any received etcd responses.
-func newUnaryInterceptor(cl *clusterLock) grpc.UnaryClientInterceptor {
+// cluster ID of any received etcd responses, and collects traffic metrics.
+func newUnaryInterceptor(cl *clusterLock, sm *streamMetrics) grpc.UnaryClientInterceptor {
 	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 		if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
 			return err
 		}
+		sm.observe(reply)
 		return validateReply(cl, reply)
 	}
 }
 
 // newStreamInterceptor returns a new stream client interceptor that validates
-// the cluster ID of any received etcd responses.
-func newStreamInterceptor(cl *clusterLock) grpc.StreamClientInterceptor {
+// the cluster ID of any received etcd responses, and collects traffic metrics.
+func newStreamInterceptor(cl *clusterLock, sm *streamMetrics) grpc.StreamClientInterceptor {
 	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
 		s, err := streamer(ctx, desc, cc, method, opts...)
 		if err != nil {
@@ -40,23 +93,41 @@ func newStreamInterceptor(cl *clusterLock) grpc.StreamClientInterceptor {
 		return &wrappedClientStream{
 			ClientStream: s,
 			clusterLock:  cl,
+			metrics:      sm,
 		}, nil
 	}
 }
 
 // wrappedClientStream is a wrapper around a grpc.ClientStream that adds
-// validation for the etcd cluster ID
+// validation for the etcd cluster ID, and collects traffic metrics.
 type wrappedClientStream struct {
 	grpc.ClientStream
 	clusterLock *clusterLock
+	metrics     *streamMetrics
+
+	// lastWatchRecv is the time the last watch response was delivered to the
+	// consumer, to measure the time spent processing it before requesting the
+	// subsequent one. It is never accessed concurrently, as gRPC forbids
+	// calling RecvMsg on the same stream from multiple goroutines.
+	lastWatchRecv time.Time
 }
 
 // RecvMsg implements the grpc.ClientStream interface, adding validation for the etcd cluster ID
 func (w *wrappedClientStream) RecvMsg(m interface{}) error {
+	if w.metrics != nil && !w.lastWatchRecv.IsZero() {
+		w.metrics.processingLatency.Observe(time.Since(w.lastWatchRecv).Seconds())
+		w.lastWatchRecv = time.Time{}
+	}
+
 	if err := w.ClientStream.RecvMsg(m); err != nil {
 		return err
 	}
 
+	w.metrics.observe(m)
+	if _, ok := m.(*etcdserverpb.WatchResponse); ok && w.metrics != nil {
+		w.lastWatchRecv = time.Now()
+	}
+
 	return validateReply(w.clusterLock, m)
 }
 
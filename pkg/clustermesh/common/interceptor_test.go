@@ -190,7 +190,7 @@ func TestInterceptors(t *testing.T) {
 				}
 			}
 
-			si := newStreamInterceptor(cl)
+			si := newStreamInterceptor(cl, nil)
 			desc := &grpc.StreamDesc{
 				StreamName:    "test",
 				Handler:       nil,
@@ -203,7 +203,7 @@ func TestInterceptors(t *testing.T) {
 			stream, err := si(ctx, desc, cc, "test", newStreamerMock)
 			require.NoError(t, err)
 
-			unaryRecvMsg := newUnaryInterceptor(cl)
+			unaryRecvMsg := newUnaryInterceptor(cl, nil)
 			for _, responder := range tt.r {
 
 				switch response := responder.recv().(type) {
@@ -15,8 +15,34 @@ type Metrics struct {
 	LastFailureTimestamp metric.Vec[metric.Gauge]
 	// ReadinessStatus tracks the readiness status of remote clusters.
 	ReadinessStatus metric.Vec[metric.Gauge]
+	// DegradedStatus tracks whether remote clusters connected but did not
+	// complete the initial synchronization within the configured deadline.
+	DegradedStatus metric.Vec[metric.Gauge]
+	// CircuitBreakerOpen tracks whether reconnection attempts to remote
+	// clusters are currently paused by the circuit breaker.
+	CircuitBreakerOpen metric.Vec[metric.Gauge]
+	// RejectedStatus tracks whether remote clusters exceed the maximum
+	// number of connected clusters and are hence rejected.
+	RejectedStatus metric.Vec[metric.Gauge]
+	// ConfigConflict tracks whether the configuration advertised by remote
+	// clusters conflicts with that of the local cluster or of another
+	// connected remote cluster.
+	ConfigConflict metric.Vec[metric.Gauge]
 	// TotalFailure tracks the number of failures when connecting to remote clusters.
 	TotalFailures metric.Vec[metric.Gauge]
+	// WatchEventProcessingLatency tracks the time spent processing the watch
+	// events received from remote clusters.
+	WatchEventProcessingLatency metric.Vec[metric.Observer]
+	// WatchEvents tracks the number of watch events received from remote
+	// clusters, per resource type.
+	WatchEvents metric.Vec[metric.Counter]
+	// ProbeLatency tracks the round-trip latency of the periodic probes
+	// towards the kvstores of remote clusters.
+	ProbeLatency metric.Vec[metric.Observer]
+	// BytesReceived tracks the number of payload bytes received from remote
+	// clusters (after decompression), partitioned by the compression
+	// algorithm configured for the connection.
+	BytesReceived metric.Vec[metric.Counter]
 }
 
 func MetricsProvider(subsystem string) func() Metrics {
@@ -43,12 +69,68 @@ func MetricsProvider(subsystem string) func() Metrics {
 				Help:      "The readiness status of the remote cluster",
 			}, []string{metrics.LabelSourceCluster, metrics.LabelSourceNodeName, metrics.LabelTargetCluster}),
 
+			DegradedStatus: metric.NewGaugeVec(metric.GaugeOpts{
+				Namespace: metrics.Namespace,
+				Subsystem: subsystem,
+				Name:      "remote_cluster_degraded_status",
+				Help:      "Whether the remote cluster connected but did not complete the initial synchronization within the configured deadline",
+			}, []string{metrics.LabelSourceCluster, metrics.LabelSourceNodeName, metrics.LabelTargetCluster}),
+
+			CircuitBreakerOpen: metric.NewGaugeVec(metric.GaugeOpts{
+				Namespace: metrics.Namespace,
+				Subsystem: subsystem,
+				Name:      "remote_cluster_circuit_breaker_open",
+				Help:      "Whether reconnection attempts to the remote cluster are currently paused by the circuit breaker",
+			}, []string{metrics.LabelSourceCluster, metrics.LabelSourceNodeName, metrics.LabelTargetCluster}),
+
+			RejectedStatus: metric.NewGaugeVec(metric.GaugeOpts{
+				Namespace: metrics.Namespace,
+				Subsystem: subsystem,
+				Name:      "remote_cluster_rejected_status",
+				Help:      "Whether the remote cluster exceeds the maximum number of connected clusters and is hence rejected",
+			}, []string{metrics.LabelSourceCluster, metrics.LabelSourceNodeName, metrics.LabelTargetCluster}),
+
+			ConfigConflict: metric.NewGaugeVec(metric.GaugeOpts{
+				Namespace: metrics.Namespace,
+				Subsystem: subsystem,
+				Name:      "remote_cluster_config_conflict",
+				Help:      "Whether the configuration advertised by the remote cluster conflicts with that of the local cluster or of another connected remote cluster",
+			}, []string{metrics.LabelSourceCluster, metrics.LabelSourceNodeName, metrics.LabelTargetCluster}),
+
 			TotalFailures: metric.NewGaugeVec(metric.GaugeOpts{
 				Namespace: metrics.Namespace,
 				Subsystem: subsystem,
 				Name:      "remote_cluster_failures",
 				Help:      "The total number of failures related to the remote cluster",
 			}, []string{metrics.LabelSourceCluster, metrics.LabelSourceNodeName, metrics.LabelTargetCluster}),
+
+			WatchEventProcessingLatency: metric.NewHistogramVec(metric.HistogramOpts{
+				Namespace: metrics.Namespace,
+				Subsystem: subsystem,
+				Name:      "remote_cluster_watch_event_processing_duration_seconds",
+				Help:      "The time spent processing the watch events received from the remote cluster",
+			}, []string{metrics.LabelSourceCluster, metrics.LabelSourceNodeName, metrics.LabelTargetCluster}),
+
+			WatchEvents: metric.NewCounterVec(metric.CounterOpts{
+				Namespace: metrics.Namespace,
+				Subsystem: subsystem,
+				Name:      "remote_cluster_watch_events_total",
+				Help:      "The total number of watch events received from the remote cluster, per resource type",
+			}, []string{metrics.LabelSourceCluster, metrics.LabelSourceNodeName, metrics.LabelTargetCluster, metrics.LabelResource}),
+
+			ProbeLatency: metric.NewHistogramVec(metric.HistogramOpts{
+				Namespace: metrics.Namespace,
+				Subsystem: subsystem,
+				Name:      "remote_cluster_probe_duration_seconds",
+				Help:      "The round-trip latency of the periodic probes towards the kvstore of the remote cluster",
+			}, []string{metrics.LabelSourceCluster, metrics.LabelSourceNodeName, metrics.LabelTargetCluster}),
+
+			BytesReceived: metric.NewCounterVec(metric.CounterOpts{
+				Namespace: metrics.Namespace,
+				Subsystem: subsystem,
+				Name:      "remote_cluster_bytes_received_total",
+				Help:      "The total number of payload bytes received from the remote cluster (after decompression), by compression algorithm",
+			}, []string{metrics.LabelSourceCluster, metrics.LabelSourceNodeName, metrics.LabelTargetCluster, metrics.LabelCompression}),
 		}
 	}
 }
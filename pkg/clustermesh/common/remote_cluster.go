@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -32,11 +33,25 @@ var (
 	clusterConfigControllerGroup    = controller.NewGroup("clustermesh-cluster-config")
 )
 
+// srvDiscoveryRefreshInterval is the interval between re-resolutions of the
+// DNS SRV records advertising the etcd endpoints of a remote cluster, when
+// SRV-based discovery is configured in place of static endpoints.
+const srvDiscoveryRefreshInterval = 1 * time.Minute
+
+// connEventsCapacity is the maximum number of connection events retained per
+// remote cluster for status reporting.
+const connEventsCapacity = 16
+
 type RemoteCluster interface {
 	// Run implements the actual business logic once the connection to the remote cluster has been established.
 	// The ready channel shall be closed when the initialization tasks completed, possibly returning an error.
 	Run(ctx context.Context, backend kvstore.BackendOperations, config types.CiliumClusterConfig, ready chan<- error)
 
+	// WaitForSync blocks until the initial synchronization of all resource
+	// types from the remote cluster has completed, the remote cluster is
+	// disconnected, or the given context is canceled.
+	WaitForSync(ctx context.Context) error
+
 	Stop()
 	Remove()
 }
@@ -59,6 +74,69 @@ type remoteCluster struct {
 	// serviceIPGetter, if not nil, is used to create a custom dialer for service resolution.
 	serviceIPGetter k8s.ServiceIPGetter
 
+	// remoteClientFactory, if not nil, replaces the creation of the direct
+	// etcd client to connect to the remote cluster with an alternative
+	// transport (e.g., a gRPC streaming API served by the
+	// clustermesh-apiserver).
+	remoteClientFactory RemoteClientFactoryFunc
+
+	// backoff governs the retry interval of the controller re-establishing
+	// the connection to the remote cluster.
+	backoff backoffConfig
+
+	// etcdOptOverrides are per-cluster overrides of the etcd client options
+	// inherited from the global kvstore configuration.
+	etcdOptOverrides map[string]string
+
+	// syncDeadline is the time after which a connected remote cluster that
+	// has not yet completed the initial synchronization is marked as
+	// degraded. Zero disables the detection.
+	syncDeadline time.Duration
+
+	// lazy defers the establishment of the connection until the remote
+	// cluster is actually referenced through use().
+	lazy bool
+
+	// idleTimeout is the period after which the connection to a no longer
+	// referenced remote cluster is torn down, when lazy is enabled. Zero
+	// keeps established connections open forever.
+	idleTimeout time.Duration
+
+	// staleTTL is the period after which the entries imported from the
+	// remote cluster are drained automatically while it remains
+	// disconnected. Zero keeps them until reconnection or removal.
+	staleTTL time.Duration
+
+	// circuitBreaker governs the pausing of the reconnection attempts when
+	// the connection to the remote cluster is flapping.
+	circuitBreaker circuitBreakerConfig
+
+	// compression is the compression algorithm applied to the payloads
+	// exchanged with the remote cluster, or empty when disabled.
+	compression string
+
+	// requireEncryption reports whether the entries imported from the remote
+	// cluster are refused when transparent encryption is not confirmed.
+	requireEncryption bool
+
+	// clientPool, if not nil, allows sharing the etcd client with the other
+	// remote clusters whose configuration points to the same etcd cluster.
+	clientPool *etcdClientPool
+
+	// probeInterval is the interval between the lightweight probes measuring
+	// the round-trip latency towards the kvstore of the remote cluster. Zero
+	// disables the probing.
+	probeInterval time.Duration
+
+	// emitEvent, if not nil, forwards the lifecycle events concerning the
+	// remote cluster to the registered sinks.
+	emitEvent func(typ LifecycleEventType, message string)
+
+	// endpoints implements the health-scored selection among the etcd
+	// endpoints of the remote cluster, when multiple are configured. It is
+	// protected by its own mutex.
+	endpoints endpointSelector
+
 	// changed receives an event when the remote cluster configuration has
 	// changed and is closed when the configuration file was removed
 	changed chan bool
@@ -79,6 +157,19 @@ type remoteCluster struct {
 	// - etcdClusterID
 	// - failures
 	// - lastFailure
+	// - degraded
+	// - configConflict
+	// - incompatibleCaps
+	// - rejected
+	// - paused
+	// - lazyActive
+	// - lastUsed
+	// - idleStop
+	// - recentFailures
+	// - circuitOpenUntil
+	// - connEvents
+	// - staleStop
+	// - cfgDigest
 	mutex lock.RWMutex
 
 	// backend is the kvstore backend being used
@@ -98,11 +189,77 @@ type remoteCluster struct {
 	// lastFailure is the timestamp of the last failure
 	lastFailure time.Time
 
+	// degraded is set when the remote cluster connected but did not complete
+	// the initial synchronization within the configured deadline.
+	degraded bool
+
+	// configConflict is set when the configuration advertised by the remote
+	// cluster conflicts with that of the local cluster or of another
+	// connected remote cluster, and the connection was refused.
+	configConflict bool
+
+	// incompatibleCaps is set when the capabilities advertised by the remote
+	// cluster are incompatible with the local cluster, and the connection
+	// was refused.
+	incompatibleCaps bool
+
+	// rejected is set when the remote cluster exceeds the maximum number of
+	// connected clusters, and the connection is hence not attempted until
+	// capacity becomes available.
+	rejected bool
+
+	// paused is set while the connection to the remote cluster is
+	// administratively paused, retaining the known entries.
+	paused bool
+
+	// lazyActive is set while the connection of a lazy remote cluster is
+	// established, that is between the first reference and the possible
+	// teardown upon idleness.
+	lazyActive bool
+
+	// lastUsed is the timestamp of the last reference to the remote
+	// cluster, when lazy is enabled.
+	lastUsed time.Time
+
+	// idleStop allows stopping the idleness watchdog of a lazy remote
+	// cluster upon disconnection.
+	idleStop chan struct{}
+
+	// staleStop allows canceling the pending expiry of the imported entries
+	// upon reconnection, when the stale entries TTL is configured.
+	staleStop chan struct{}
+
+	// recentFailures are the timestamps of the failures observed within
+	// the circuit breaker window.
+	recentFailures []time.Time
+
+	// circuitOpenUntil is the time until which reconnection attempts are
+	// paused by the circuit breaker.
+	circuitOpenUntil time.Time
+
+	// connEvents is the bounded history of the most recent connection
+	// events, from oldest to newest, for status reporting.
+	connEvents []*models.RemoteClusterConnectionEvent
+
+	// cfgDigest is the semantic digest of the etcd configuration file in
+	// use by the current connection, to skip the re-creation of the
+	// connection upon file modifications that do not actually alter it.
+	cfgDigest string
+
 	logger logrus.FieldLogger
 
 	metricLastFailureTimestamp prometheus.Gauge
 	metricReadinessStatus      prometheus.Gauge
 	metricTotalFailures        prometheus.Gauge
+	metricDegradedStatus       prometheus.Gauge
+	metricCircuitBreakerOpen   prometheus.Gauge
+	metricConfigConflict       prometheus.Gauge
+	metricRejectedStatus       prometheus.Gauge
+	metricProbeLatency         prometheus.Observer
+
+	// trafficMetrics are the traffic metrics collected by the client
+	// interceptors for this remote cluster.
+	trafficMetrics *streamMetrics
 }
 
 var (
@@ -122,8 +279,14 @@ func (rc *remoteCluster) releaseOldConnection() {
 	rc.backend = nil
 	rc.config = nil
 	rc.etcdClusterID = ""
+	rc.degraded = false
+	rc.configConflict = false
+	rc.incompatibleCaps = false
 	rc.mutex.Unlock()
 
+	rc.metricDegradedStatus.Set(metrics.BoolToFloat64(false))
+	rc.metricConfigConflict.Set(metrics.BoolToFloat64(false))
+
 	// Release resources asynchronously in the background. Many of these
 	// operations may time out if the connection was closed due to an error
 	// condition.
@@ -138,16 +301,56 @@ func (rc *remoteCluster) restartRemoteConnection() {
 	rc.controllers.UpdateController(
 		rc.remoteConnectionControllerName,
 		controller.ControllerParams{
-			Group: remoteConnectionControllerGroup,
+			Group:                  remoteConnectionControllerGroup,
+			ErrorRetryBaseDuration: rc.backoff.base,
+			MaxRetryInterval:       rc.backoff.max,
+			RetryJitter:            rc.backoff.jitter,
 			DoFunc: func(ctx context.Context) error {
+				if delay := rc.circuitBreakerDelay(); delay > 0 {
+					rc.logger.WithField(logfields.Duration, delay).
+						Warning("Circuit breaker open, pausing reconnection to remote cluster")
+					select {
+					case <-ctx.Done():
+						return ctx.Err()
+					case <-time.After(delay):
+					}
+					rc.metricCircuitBreakerOpen.Set(metrics.BoolToFloat64(false))
+					rc.logger.Info("Circuit breaker cool-down elapsed, resuming reconnection to remote cluster")
+				}
+
 				rc.releaseOldConnection()
 
 				clusterLock := newClusterLock()
 
 				extraOpts := rc.makeExtraOpts(clusterLock)
 
-				backend, errChan := kvstore.NewClient(ctx, kvstore.EtcdBackendName,
-					rc.makeEtcdOpts(), &extraOpts)
+				// Restrict the etcd endpoints to the address families enabled
+				// in the local cluster, and try the healthiest one first, based
+				// on the failures observed so far, alternating the families
+				// when both are configured.
+				if eps, err := kvstore.EtcdEndpoints(rc.configPath); err == nil && len(eps) > 0 {
+					usable, err := selectEndpointFamilies(eps, option.Config.IPv4Enabled(), option.Config.IPv6Enabled())
+					if err != nil {
+						rc.logger.WithError(err).Error("No usable etcd endpoints to connect to remote cluster")
+						rc.recordConnEvent(models.RemoteClusterConnectionEventTypeFailure, err.Error())
+						return err
+					}
+
+					if len(usable) != len(eps) || len(usable) > 1 {
+						extraOpts.Endpoints = interleaveFamilies(rc.endpoints.order(usable))
+					}
+				}
+
+				// Record the semantic digest of the configuration being used,
+				// to subsequently detect, and ignore, file modifications that
+				// do not actually alter it.
+				if digest, err := kvstore.EtcdConfigDigest(rc.configPath); err == nil {
+					rc.setConfigDigest(digest)
+				} else {
+					rc.setConfigDigest("")
+				}
+
+				backend, errChan := rc.newRemoteClient(ctx, &extraOpts)
 
 				// Block until either an error is returned or
 				// the channel is closed due to success of the
@@ -164,15 +367,23 @@ func (rc *remoteCluster) restartRemoteConnection() {
 					if backend != nil {
 						backend.Close(ctx)
 					}
+					rc.endpoints.observeFailure()
+					rc.recordConnEvent(models.RemoteClusterConnectionEventTypeFailure, err.Error())
+					rc.scheduleStaleDrain()
 					rc.logger.WithError(err).Warning("Unable to establish etcd connection to remote cluster")
+					rc.notifyLifecycle(LifecycleEventFailure, err.Error())
 					return err
 				}
 
+				rc.endpoints.observeSuccess()
+
 				etcdClusterID := fmt.Sprintf("%x", clusterLock.etcdClusterID.Load())
 
 				rc.mutex.Lock()
 				rc.backend = backend
 				rc.etcdClusterID = etcdClusterID
+				rc.recordConnEventLocked(models.RemoteClusterConnectionEventTypeConnected, "")
+				rc.cancelStaleDrainLocked()
 				rc.mutex.Unlock()
 
 				ctx, cancel := context.WithCancel(ctx)
@@ -184,6 +395,7 @@ func (rc *remoteCluster) restartRemoteConnection() {
 				}()
 
 				rc.logger.WithField(logfields.EtcdClusterID, etcdClusterID).Info("Connection to remote cluster established")
+				rc.notifyLifecycle(LifecycleEventConnected, "")
 
 				config, err := rc.getClusterConfig(ctx, backend)
 				if err != nil {
@@ -195,6 +407,7 @@ func (rc *remoteCluster) restartRemoteConnection() {
 					}
 
 					lgr.WithError(err).Warning("Unable to get remote cluster configuration")
+					rc.recordConnEvent(models.RemoteClusterConnectionEventTypeFailure, err.Error())
 					cancel()
 					return err
 				}
@@ -214,10 +427,63 @@ func (rc *remoteCluster) restartRemoteConnection() {
 				}()
 
 				if err := <-ready; err != nil {
+					rc.recordConnEvent(models.RemoteClusterConnectionEventTypeFailure, err.Error())
+
+					if errors.Is(err, types.ErrIncompatibleCapabilities) {
+						rc.mutex.Lock()
+						rc.incompatibleCaps = true
+						rc.mutex.Unlock()
+
+						rc.logger.WithError(err).Error("Remote cluster advertises capabilities incompatible with the local cluster, refusing connection")
+						return err
+					}
+
+					if errors.Is(err, types.ErrClusterConfigConflict) {
+						rc.mutex.Lock()
+						rc.configConflict = true
+						rc.mutex.Unlock()
+
+						rc.metricConfigConflict.Set(metrics.BoolToFloat64(true))
+						rc.logger.WithError(err).Error("Remote cluster configuration conflicts with the local cluster, refusing connection")
+						return err
+					}
+
 					rc.logger.WithError(err).Warning("Connection to remote cluster failed")
 					return err
 				}
 
+				rc.wg.Add(1)
+				go func() {
+					if err := rc.WaitForSync(ctx); err == nil {
+						rc.notifyLifecycle(LifecycleEventSyncCompleted, "")
+					}
+					rc.wg.Done()
+				}()
+
+				if rc.syncDeadline > 0 {
+					rc.wg.Add(1)
+					go func() {
+						rc.watchSyncDeadline(ctx)
+						rc.wg.Done()
+					}()
+				}
+
+				if rc.probeInterval > 0 {
+					rc.wg.Add(1)
+					go func() {
+						rc.probeLatency(ctx, backend)
+						rc.wg.Done()
+					}()
+				}
+
+				if domain, err := kvstore.EtcdDiscoverySrv(rc.configPath); err == nil && domain != "" {
+					rc.wg.Add(1)
+					go func() {
+						rc.watchSrvRecords(ctx, domain)
+						rc.wg.Done()
+					}()
+				}
+
 				rc.metricReadinessStatus.Set(metrics.BoolToFloat64(true))
 				return nil
 			},
@@ -231,6 +497,226 @@ func (rc *remoteCluster) restartRemoteConnection() {
 	)
 }
 
+// watchSyncDeadline marks the remote cluster as degraded if the initial
+// synchronization does not complete within the configured deadline, rather
+// than reporting it as ready forever based on the established connection
+// only. The degraded state is cleared if the synchronization eventually
+// completes, or when the connection is re-created.
+func (rc *remoteCluster) watchSyncDeadline(ctx context.Context) {
+	sctx, cancel := context.WithTimeout(ctx, rc.syncDeadline)
+	defer cancel()
+
+	if err := rc.WaitForSync(sctx); err == nil || !errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+
+	rc.logger.WithField(logfields.Duration, rc.syncDeadline).
+		Warning("Remote cluster did not complete the initial synchronization within deadline, marking as degraded")
+	rc.setDegraded(true)
+
+	// Clear the degraded state in case the synchronization eventually
+	// completes.
+	if err := rc.WaitForSync(ctx); err == nil {
+		rc.logger.Info("Remote cluster completed the initial synchronization, clearing degraded state")
+		rc.setDegraded(false)
+	}
+}
+
+// probeLatency periodically measures the round-trip latency towards the
+// kvstore of the remote cluster, through a lightweight ranged get of the
+// heartbeat key, and reports it through the configured histogram metric,
+// allowing to correlate cross-cluster issues with WAN latency. Failed
+// probes are not reported, as they would skew the latency distribution,
+// and connection failures are already surfaced separately.
+func (rc *remoteCluster) probeLatency(ctx context.Context, backend kvstore.BackendOperations) {
+	ticker := time.NewTicker(rc.probeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		start := time.Now()
+		if _, err := backend.ListPrefix(ctx, kvstore.HeartbeatPath); err != nil {
+			rc.logger.WithError(err).Debug("Failed to probe the latency towards the remote cluster kvstore")
+			continue
+		}
+
+		rc.metricProbeLatency.Observe(time.Since(start).Seconds())
+	}
+}
+
+// watchSrvRecords periodically re-resolves the DNS SRV records advertising
+// the etcd endpoints of the remote cluster, and triggers the re-creation of
+// the connection when the record set changes, so that added or removed
+// endpoints are picked up without requiring a configuration change.
+func (rc *remoteCluster) watchSrvRecords(ctx context.Context, domain string) {
+	current, err := kvstore.SrvDiscoveryEndpoints(ctx, domain)
+	if err != nil {
+		rc.logger.WithError(err).Debug("Unable to resolve etcd SRV records")
+	}
+
+	ticker := time.NewTicker(srvDiscoveryRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		endpoints, err := kvstore.SrvDiscoveryEndpoints(ctx, domain)
+		if err != nil {
+			rc.logger.WithError(err).Debug("Unable to resolve etcd SRV records")
+			continue
+		}
+
+		if current == nil {
+			// The baseline resolution failed, adopt the first successful
+			// one without triggering a reconnection.
+			current = endpoints
+			continue
+		}
+
+		if slices.Equal(endpoints, current) {
+			continue
+		}
+
+		rc.logger.Info("etcd SRV record set has changed, re-creating connection")
+
+		// Invalidate the configuration digest, given that the re-creation of
+		// the connection is required even though the configuration file
+		// itself did not change.
+		rc.setConfigDigest("")
+
+		select {
+		case rc.changed <- true:
+		case <-ctx.Done():
+		}
+		return
+	}
+}
+
+// setConfigDigest stores the semantic digest of the etcd configuration file
+// in use by the current connection.
+func (rc *remoteCluster) setConfigDigest(digest string) {
+	rc.mutex.Lock()
+	rc.cfgDigest = digest
+	rc.mutex.Unlock()
+}
+
+// configUnchanged returns whether the semantic digest of the etcd
+// configuration file matches the one in use by the current connection, that
+// is whether a modification of the file can be ignored without re-creating
+// the connection. Errors retrieving the digest are treated as changes, to
+// err on the side of reconnecting.
+func (rc *remoteCluster) configUnchanged() bool {
+	digest, err := kvstore.EtcdConfigDigest(rc.configPath)
+	if err != nil {
+		return false
+	}
+
+	rc.mutex.RLock()
+	defer rc.mutex.RUnlock()
+	return rc.cfgDigest != "" && digest == rc.cfgDigest
+}
+
+// recordCircuitBreakerFailureLocked registers a connection failure for the
+// circuit breaker, and returns whether the failure caused the circuit to
+// open. It shall be called while holding rc.mutex.
+func (rc *remoteCluster) recordCircuitBreakerFailureLocked() bool {
+	if rc.circuitBreaker.threshold <= 0 {
+		return false
+	}
+
+	now := time.Now()
+	rc.recentFailures = append(rc.recentFailures, now)
+	for len(rc.recentFailures) > 0 && now.Sub(rc.recentFailures[0]) > rc.circuitBreaker.window {
+		rc.recentFailures = rc.recentFailures[1:]
+	}
+
+	if len(rc.recentFailures) >= rc.circuitBreaker.threshold && !now.Before(rc.circuitOpenUntil) {
+		rc.circuitOpenUntil = now.Add(rc.circuitBreaker.cooldown)
+		rc.recentFailures = nil
+		return true
+	}
+
+	return false
+}
+
+// circuitBreakerDelay returns the remaining time during which reconnection
+// attempts shall be paused, or zero if the circuit breaker is closed.
+func (rc *remoteCluster) circuitBreakerDelay() time.Duration {
+	rc.mutex.RLock()
+	defer rc.mutex.RUnlock()
+
+	return time.Until(rc.circuitOpenUntil)
+}
+
+// recordConnEvent appends an event to the bounded connection event history
+// surfaced through the status API, dropping the oldest entry when the
+// capacity is exceeded.
+func (rc *remoteCluster) recordConnEvent(typ, reason string) {
+	rc.mutex.Lock()
+	rc.recordConnEventLocked(typ, reason)
+	rc.mutex.Unlock()
+}
+
+// notifyLifecycle forwards the given lifecycle event to the registered
+// sinks, if any.
+func (rc *remoteCluster) notifyLifecycle(typ LifecycleEventType, message string) {
+	if rc.emitEvent != nil {
+		rc.emitEvent(typ, message)
+	}
+}
+
+func (rc *remoteCluster) recordConnEventLocked(typ, reason string) {
+	rc.connEvents = append(rc.connEvents, &models.RemoteClusterConnectionEvent{
+		Timestamp: strfmt.DateTime(time.Now()),
+		Type:      typ,
+		Reason:    reason,
+	})
+
+	if len(rc.connEvents) > connEventsCapacity {
+		rc.connEvents = rc.connEvents[1:]
+	}
+}
+
+// setRejected marks whether the remote cluster exceeds the maximum number of
+// connected clusters, in which case the connection is not attempted.
+func (rc *remoteCluster) setRejected(rejected bool) {
+	rc.mutex.Lock()
+	rc.rejected = rejected
+	rc.metricRejectedStatus.Set(metrics.BoolToFloat64(rejected))
+	rc.mutex.Unlock()
+}
+
+func (rc *remoteCluster) isRejected() bool {
+	rc.mutex.RLock()
+	defer rc.mutex.RUnlock()
+
+	return rc.rejected
+}
+
+func (rc *remoteCluster) isPaused() bool {
+	rc.mutex.RLock()
+	defer rc.mutex.RUnlock()
+
+	return rc.paused
+}
+
+func (rc *remoteCluster) setDegraded(degraded bool) {
+	rc.mutex.Lock()
+	rc.degraded = degraded
+	rc.metricDegradedStatus.Set(metrics.BoolToFloat64(degraded))
+	rc.metricReadinessStatus.Set(metrics.BoolToFloat64(rc.isReadyLocked()))
+	rc.mutex.Unlock()
+}
+
 func (rc *remoteCluster) watchdog(ctx context.Context, backend kvstore.BackendOperations, clusterLock *clusterLock) {
 	handleErr := func(err error) {
 		rc.logger.WithError(err).Warning("Error observed on etcd connection, reconnecting etcd")
@@ -240,8 +726,20 @@ func (rc *remoteCluster) watchdog(ctx context.Context, backend kvstore.BackendOp
 		rc.metricLastFailureTimestamp.SetToCurrentTime()
 		rc.metricTotalFailures.Set(float64(rc.failures))
 		rc.metricReadinessStatus.Set(metrics.BoolToFloat64(rc.isReadyLocked()))
+		opened := rc.recordCircuitBreakerFailureLocked()
+		rc.recordConnEventLocked(models.RemoteClusterConnectionEventTypeFailure, err.Error())
 		rc.mutex.Unlock()
 
+		rc.scheduleStaleDrain()
+
+		if opened {
+			rc.metricCircuitBreakerOpen.Set(metrics.BoolToFloat64(true))
+			rc.logger.WithField(logfields.Duration, rc.circuitBreaker.cooldown).
+				Warning("Connection to remote cluster is flapping, opening circuit breaker")
+		}
+
+		rc.endpoints.observeFailure()
+		rc.notifyLifecycle(LifecycleEventFailure, err.Error())
 		rc.restartRemoteConnection()
 	}
 
@@ -319,6 +817,37 @@ func (rc *remoteCluster) getClusterConfig(ctx context.Context, backend kvstore.B
 	}
 }
 
+// newRemoteClient returns a new kvstore client providing access to the data
+// of the remote cluster, through the configured transport factory if present,
+// or a direct etcd connection otherwise.
+func (rc *remoteCluster) newRemoteClient(ctx context.Context, extraOpts *kvstore.ExtraOptions) (kvstore.BackendOperations, chan error) {
+	if rc.remoteClientFactory != nil {
+		return rc.remoteClientFactory(ctx, rc.makeEtcdOpts(), extraOpts)
+	}
+
+	// Share the etcd client with the other remote clusters whose configuration
+	// points to the same etcd cluster, when enabled. Clusters with per-cluster
+	// etcd option overrides are excluded from sharing, as the overrides would
+	// not be honored by a client created on behalf of a different cluster.
+	if rc.clientPool != nil && len(rc.etcdOptOverrides) == 0 {
+		if key, err := kvstore.EtcdConfigDigest(rc.configPath); err == nil {
+			if backend, errch := rc.clientPool.acquire(key); backend != nil {
+				rc.logger.Info("Sharing established etcd client with other remote clusters")
+				return backend, errch
+			}
+
+			backend, errch := kvstore.NewClient(ctx, kvstore.EtcdBackendName, rc.makeEtcdOpts(), extraOpts)
+			if backend == nil {
+				return backend, errch
+			}
+
+			return rc.clientPool.insert(key, backend, errch)
+		}
+	}
+
+	return kvstore.NewClient(ctx, kvstore.EtcdBackendName, rc.makeEtcdOpts(), extraOpts)
+}
+
 func (rc *remoteCluster) makeEtcdOpts() map[string]string {
 	opts := map[string]string{
 		kvstore.EtcdOptionConfig: rc.configPath,
@@ -332,13 +861,23 @@ func (rc *remoteCluster) makeEtcdOpts() map[string]string {
 		}
 	}
 
+	// Per-cluster overrides take precedence over the global options, so that
+	// e.g. a slow WAN-connected cluster can be tuned independently.
+	for key, value := range rc.etcdOptOverrides {
+		opts[key] = value
+	}
+
 	return opts
 }
 
 func (rc *remoteCluster) makeExtraOpts(clusterLock *clusterLock) kvstore.ExtraOptions {
 	var dialOpts []grpc.DialOption
 
-	dialOpts = append(dialOpts, grpc.WithStreamInterceptor(newStreamInterceptor(clusterLock)), grpc.WithUnaryInterceptor(newUnaryInterceptor(clusterLock)))
+	dialOpts = append(dialOpts, grpc.WithStreamInterceptor(newStreamInterceptor(clusterLock, rc.trafficMetrics)), grpc.WithUnaryInterceptor(newUnaryInterceptor(clusterLock, rc.trafficMetrics)))
+
+	if rc.compression != "" {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(grpc.UseCompressor(rc.compression)))
+	}
 
 	if rc.serviceIPGetter != nil {
 		// Allow to resolve service names without depending on the DNS. This prevents the need
@@ -355,6 +894,197 @@ func (rc *remoteCluster) makeExtraOpts(clusterLock *clusterLock) kvstore.ExtraOp
 	}
 }
 
+// use marks the remote cluster as currently referenced, establishing the
+// connection upon the first reference when lazy connections are enabled.
+// It is a no-op otherwise, as the connection is managed eagerly.
+func (rc *remoteCluster) use() {
+	if !rc.lazy || skipKvstoreConnection {
+		return
+	}
+
+	rc.mutex.Lock()
+	if rc.rejected || rc.paused {
+		rc.mutex.Unlock()
+		return
+	}
+
+	rc.lastUsed = time.Now()
+	if rc.lazyActive {
+		rc.mutex.Unlock()
+		return
+	}
+
+	rc.lazyActive = true
+	stop := make(chan struct{})
+	rc.idleStop = stop
+	rc.mutex.Unlock()
+
+	rc.logger.Info("Remote cluster referenced for the first time, establishing connection")
+	rc.restartRemoteConnection()
+
+	if rc.idleTimeout > 0 {
+		go rc.idleWatchdog(stop)
+	}
+}
+
+// scheduleStaleDrain starts the expiry of the entries imported from the
+// remote cluster upon a connection failure, unless already in progress.
+func (rc *remoteCluster) scheduleStaleDrain() {
+	if rc.staleTTL <= 0 {
+		return
+	}
+
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	if rc.staleStop != nil {
+		return
+	}
+
+	stop := make(chan struct{})
+	rc.staleStop = stop
+	go rc.staleWatchdog(stop)
+}
+
+// cancelStaleDrainLocked stops the pending expiry of the imported entries,
+// either because the connection was re-established or because the remote
+// cluster is being stopped.
+func (rc *remoteCluster) cancelStaleDrainLocked() {
+	if rc.staleStop != nil {
+		close(rc.staleStop)
+		rc.staleStop = nil
+	}
+}
+
+// staleWatchdog drains the entries imported from the remote cluster if the
+// connection could not be re-established within the configured TTL, so that
+// a permanently decommissioned cluster does not leave stale entries forever.
+func (rc *remoteCluster) staleWatchdog(stop chan struct{}) {
+	select {
+	case <-stop:
+		return
+	case <-time.After(rc.staleTTL):
+	}
+
+	rc.mutex.Lock()
+	if rc.staleStop != stop {
+		rc.mutex.Unlock()
+		return
+	}
+	rc.staleStop = nil
+	rc.recordConnEventLocked(models.RemoteClusterConnectionEventTypeDisconnected, "stale entries drained after prolonged disconnection")
+	rc.mutex.Unlock()
+
+	rc.logger.WithField(logfields.Duration, rc.staleTTL).
+		Warning("Remote cluster has been disconnected for too long, draining stale entries")
+
+	// Stop the reconnection attempts while draining, to prevent concurrent
+	// operations on the watch stores, and restart them afterwards, so that
+	// the entries are re-imported if the remote cluster eventually recovers.
+	_ = rc.controllers.RemoveControllerAndWait(rc.remoteConnectionControllerName)
+	rc.Remove()
+	rc.notifyLifecycle(LifecycleEventDrained, "stale entries drained after prolonged disconnection")
+	rc.restartRemoteConnection()
+}
+
+// idleWatchdog tears down the connection to a lazy remote cluster that has
+// no longer been referenced for the configured idle timeout.
+func (rc *remoteCluster) idleWatchdog(stop chan struct{}) {
+	for {
+		rc.mutex.RLock()
+		remaining := rc.idleTimeout - time.Since(rc.lastUsed)
+		rc.mutex.RUnlock()
+
+		if remaining > 0 {
+			select {
+			case <-stop:
+				return
+			case <-time.After(remaining):
+			}
+			continue
+		}
+
+		rc.mutex.Lock()
+		if time.Since(rc.lastUsed) < rc.idleTimeout {
+			rc.mutex.Unlock()
+			continue
+		}
+		rc.lazyActive = false
+		rc.idleStop = nil
+		rc.recordConnEventLocked(models.RemoteClusterConnectionEventTypeDisconnected, "idle timeout expired")
+		rc.mutex.Unlock()
+
+		rc.logger.WithField(logfields.Duration, rc.idleTimeout).
+			Info("Tearing down connection to remote cluster no longer referenced")
+		_ = rc.controllers.RemoveControllerAndWait(rc.remoteConnectionControllerName)
+		return
+	}
+}
+
+// pause administratively stops the connection to the remote cluster,
+// retaining the known entries.
+func (rc *remoteCluster) pause() {
+	if skipKvstoreConnection {
+		return
+	}
+
+	rc.mutex.Lock()
+	if rc.paused {
+		rc.mutex.Unlock()
+		return
+	}
+	rc.paused = true
+	rc.recordConnEventLocked(models.RemoteClusterConnectionEventTypeDisconnected, "connection administratively paused")
+	// A paused cluster deliberately retains the known entries.
+	rc.cancelStaleDrainLocked()
+	rc.mutex.Unlock()
+
+	rc.logger.Info("Pausing connection to remote cluster")
+	_ = rc.controllers.RemoveControllerAndWait(rc.remoteConnectionControllerName)
+}
+
+// resume re-establishes the connection to a previously paused remote cluster.
+func (rc *remoteCluster) resume() {
+	if skipKvstoreConnection {
+		return
+	}
+
+	rc.mutex.Lock()
+	if !rc.paused {
+		rc.mutex.Unlock()
+		return
+	}
+	rc.paused = false
+	dormant := rc.lazy && !rc.lazyActive
+	rc.mutex.Unlock()
+
+	if dormant {
+		rc.logger.Info("Resuming remote cluster, connection deferred until first reference")
+		return
+	}
+
+	rc.logger.Info("Resuming connection to remote cluster")
+	rc.restartRemoteConnection()
+}
+
+// admit clears the rejected state of the remote cluster once capacity became
+// available, establishing the connection unless it is managed lazily.
+func (rc *remoteCluster) admit() {
+	rc.setRejected(false)
+
+	if skipKvstoreConnection {
+		return
+	}
+
+	if rc.lazy {
+		rc.logger.Info("Remote cluster admitted, connection deferred until first reference")
+		return
+	}
+
+	rc.logger.Info("Remote cluster admitted, establishing connection")
+	rc.restartRemoteConnection()
+}
+
 func (rc *remoteCluster) onInsert() {
 	rc.logger.Info("New remote cluster configuration")
 
@@ -363,13 +1093,37 @@ func (rc *remoteCluster) onInsert() {
 	}
 
 	rc.remoteConnectionControllerName = fmt.Sprintf("remote-etcd-%s", rc.name)
-	rc.restartRemoteConnection()
+	switch {
+	case rc.isRejected():
+		rc.logger.Error("Maximum number of connected clusters exceeded, rejecting connection to remote cluster")
+	case rc.isPaused():
+		rc.logger.Info("Remote cluster administratively paused, deferring connection until resumed")
+	case rc.lazy:
+		rc.logger.Info("Lazy connection mode enabled, deferring connection until first reference")
+	default:
+		rc.restartRemoteConnection()
+	}
 
 	go func() {
 		for {
 			val := <-rc.changed
 			if val {
+				if rc.configUnchanged() {
+					rc.logger.Debug("etcd configuration file modified, but semantically unchanged, preserving connection")
+					continue
+				}
+
+				rc.mutex.RLock()
+				dormant := rc.rejected || rc.paused || (rc.lazy && !rc.lazyActive)
+				rc.mutex.RUnlock()
+
+				if dormant {
+					rc.logger.Debug("etcd configuration has changed, re-creation of the connection deferred")
+					continue
+				}
+
 				rc.logger.Info("etcd configuration has changed, re-creating connection")
+				rc.notifyLifecycle(LifecycleEventConfigChanged, "")
 				rc.restartRemoteConnection()
 			} else {
 				rc.logger.Info("Closing connection to remote etcd")
@@ -383,6 +1137,14 @@ func (rc *remoteCluster) onInsert() {
 // In this case, we don't want to drain the known entries, otherwise
 // we would break existing connections when the agent gets restarted.
 func (rc *remoteCluster) onStop() {
+	rc.mutex.Lock()
+	if rc.idleStop != nil {
+		close(rc.idleStop)
+		rc.idleStop = nil
+	}
+	rc.cancelStaleDrainLocked()
+	rc.mutex.Unlock()
+
 	_ = rc.controllers.RemoveControllerAndWait(rc.remoteConnectionControllerName)
 	close(rc.changed)
 	rc.Stop()
@@ -395,10 +1157,21 @@ func (rc *remoteCluster) onStop() {
 func (rc *remoteCluster) onRemove() {
 	rc.onStop()
 	rc.Remove()
+	rc.notifyLifecycle(LifecycleEventDrained, "remote cluster disconnected")
 
 	rc.logger.Info("Remote cluster disconnected")
 }
 
+// drain drops all the entries known from the remote cluster. It is executed
+// when the grace period following the disconnection of the remote cluster
+// expires, when the delayed drain mode is configured.
+func (rc *remoteCluster) drain() {
+	rc.Remove()
+	rc.notifyLifecycle(LifecycleEventDrained, "grace period expired after disconnection")
+
+	rc.logger.Info("Drained entries of disconnected remote cluster")
+}
+
 func (rc *remoteCluster) isReady() bool {
 	rc.mutex.RLock()
 	defer rc.mutex.RUnlock()
@@ -407,7 +1180,8 @@ func (rc *remoteCluster) isReady() bool {
 }
 
 func (rc *remoteCluster) isReadyLocked() bool {
-	return rc.backend != nil && rc.config != nil && (!rc.config.Required || rc.config.Retrieved)
+	return rc.backend != nil && rc.config != nil && (!rc.config.Required || rc.config.Retrieved) &&
+		!rc.degraded && !rc.configConflict && !rc.incompatibleCaps
 }
 
 func (rc *remoteCluster) status() *models.RemoteCluster {
@@ -417,6 +1191,14 @@ func (rc *remoteCluster) status() *models.RemoteCluster {
 	// This can happen when the controller in restartRemoteConnection is waiting
 	// for the first connection to succeed.
 	var backendStatus = "Waiting for initial connection to be established"
+	switch {
+	case rc.rejected:
+		backendStatus = "Connection rejected: maximum number of connected clusters exceeded"
+	case rc.paused:
+		backendStatus = "Connection administratively paused"
+	case rc.lazy && !rc.lazyActive:
+		backendStatus = "Connection deferred until first reference"
+	}
 	if rc.backend != nil {
 		var backendError error
 		backendStatus, backendError = rc.backend.Status()
@@ -429,14 +1211,35 @@ func (rc *remoteCluster) status() *models.RemoteCluster {
 		}
 	}
 
+	if rc.degraded {
+		backendStatus += "; degraded: initial synchronization not completed within deadline"
+	}
+
+	if rc.configConflict {
+		backendStatus += "; connection refused: remote cluster configuration conflicts with the local cluster"
+	}
+
+	if rc.incompatibleCaps {
+		backendStatus += "; connection refused: remote cluster capabilities are incompatible with the local cluster"
+	}
+
+	if until := rc.circuitOpenUntil; time.Now().Before(until) {
+		backendStatus += "; circuit breaker open, reconnection paused until " + until.Format(time.RFC3339)
+	}
+
+	if rc.requireEncryption {
+		backendStatus += "; encryption enforced for imported entries"
+	}
+
 	status := &models.RemoteCluster{
-		Name:        rc.name,
-		Ready:       rc.isReadyLocked(),
-		Connected:   rc.backend != nil,
-		Status:      backendStatus,
-		Config:      rc.config,
-		NumFailures: int64(rc.failures),
-		LastFailure: strfmt.DateTime(rc.lastFailure),
+		Name:             rc.name,
+		Ready:            rc.isReadyLocked(),
+		Connected:        rc.backend != nil,
+		Status:           backendStatus,
+		Config:           rc.config,
+		NumFailures:      int64(rc.failures),
+		LastFailure:      strfmt.DateTime(rc.lastFailure),
+		ConnectionEvents: slices.Clone(rc.connEvents),
 	}
 
 	return status
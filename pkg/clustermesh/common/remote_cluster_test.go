@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package common
+
+import (
+	"context"
+	"errors"
+	"path"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cilium/hive/hivetest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/clustermesh/types"
+	"github.com/cilium/cilium/pkg/kvstore"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// trackedRemoteCluster counts the drains of the entries imported from the
+// remote cluster.
+type trackedRemoteCluster struct {
+	fakeRemoteCluster
+
+	removed atomic.Int32
+}
+
+func (c *trackedRemoteCluster) Remove() { c.removed.Add(1) }
+
+// watchingRemoteCluster completes the initialization only once the initial
+// list operation of the watch against the remote kvstore terminated, to
+// exercise the delayed watches of the fake kvstore.
+type watchingRemoteCluster struct {
+	fakeRemoteCluster
+}
+
+func (*watchingRemoteCluster) Run(ctx context.Context, backend kvstore.BackendOperations, _ types.CiliumClusterConfig, ready chan<- error) {
+	watcher := backend.ListAndWatch(ctx, kvstore.BaseKeyPrefix, 16)
+
+	closed := false
+	for event := range watcher.Events {
+		if event.Typ == kvstore.EventTypeListDone && !closed {
+			close(ready)
+			closed = true
+		}
+	}
+
+	if !closed {
+		ready <- ctx.Err()
+		close(ready)
+	}
+}
+
+// eventRecorder collects the emitted lifecycle events.
+type eventRecorder struct {
+	mutex  lock.Mutex
+	events []LifecycleEvent
+}
+
+func (r *eventRecorder) HandleLifecycleEvent(event LifecycleEvent) {
+	r.mutex.Lock()
+	r.events = append(r.events, event)
+	r.mutex.Unlock()
+}
+
+func (r *eventRecorder) count(typ LifecycleEventType) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var count int
+	for _, event := range r.events {
+		if event.Type == typ {
+			count++
+		}
+	}
+	return count
+}
+
+func setupFakeClusterMesh(t *testing.T, factory *fakeKvstoreFactory, creator RemoteClusterCreatorFunc, cfg Config) *clusterMesh {
+	t.Helper()
+
+	baseDir := t.TempDir()
+	writeFile(t, path.Join(baseDir, "cluster1"), content1)
+	factory.setClusterConfig("cluster1", types.CiliumClusterConfig{ID: 1})
+
+	cfg.ClusterMeshConfig = baseDir
+	cfg.ClusterMeshReconnectBackoffBase = 10 * time.Millisecond
+	cfg.ClusterMeshReconnectBackoffMax = 50 * time.Millisecond
+
+	gcm := NewClusterMesh(Configuration{
+		Config:              cfg,
+		ClusterInfo:         types.ClusterInfo{ID: 255, Name: "test2"},
+		NewRemoteCluster:    creator,
+		RemoteClientFactory: factory.NewClient,
+		Metrics:             MetricsProvider("clustermesh")(),
+	})
+	return gcm.(*clusterMesh)
+}
+
+func waitForCluster(t *testing.T, cm *clusterMesh, name string, ready bool) *remoteCluster {
+	t.Helper()
+
+	var rc *remoteCluster
+	require.Eventually(t, func() bool {
+		cm.mutex.RLock()
+		rc = cm.clusters[name]
+		cm.mutex.RUnlock()
+		return rc != nil && rc.isReady() == ready
+	}, timeout, tick)
+	return rc
+}
+
+func TestRemoteClusterReconnection(t *testing.T) {
+	factory := newFakeKvstoreFactory()
+	cm := setupFakeClusterMesh(t, factory, func(string, StatusFunc) RemoteCluster { return &fakeRemoteCluster{} }, Config{})
+	hivetest.Lifecycle(t).Append(cm)
+
+	rc := waitForCluster(t, cm, "cluster1", true)
+
+	// A status-check failure shall trigger an automatic reconnection.
+	factory.failStatusChecks(errors.New("injected status-check failure"))
+	require.Eventually(t, func() bool {
+		rc.mutex.RLock()
+		defer rc.mutex.RUnlock()
+		return rc.failures > 0
+	}, timeout, tick)
+	waitForCluster(t, cm, "cluster1", true)
+
+	// Reconnection attempts shall fail while the partition is active.
+	factory.partition()
+	factory.failStatusChecks(errors.New("injected status-check failure"))
+	waitForCluster(t, cm, "cluster1", false)
+
+	// The connection shall be re-established once the partition healed.
+	factory.heal()
+	waitForCluster(t, cm, "cluster1", true)
+}
+
+func TestRemoteClusterStaleDrain(t *testing.T) {
+	factory := newFakeKvstoreFactory()
+	tracked := &trackedRemoteCluster{}
+	cm := setupFakeClusterMesh(t, factory, func(string, StatusFunc) RemoteCluster { return tracked },
+		Config{ClusterMeshStaleEntriesTTL: 100 * time.Millisecond})
+	hivetest.Lifecycle(t).Append(cm)
+
+	waitForCluster(t, cm, "cluster1", true)
+	require.EqualValues(t, 0, tracked.removed.Load())
+
+	// The imported entries shall be drained when the remote cluster remains
+	// disconnected for longer than the configured TTL.
+	factory.partition()
+	factory.failStatusChecks(errors.New("injected status-check failure"))
+	require.Eventually(t, func() bool { return tracked.removed.Load() > 0 }, timeout, tick)
+
+	// The connection shall be re-established once the partition healed.
+	factory.heal()
+	waitForCluster(t, cm, "cluster1", true)
+}
+
+func TestRemoteClusterDelayedWatch(t *testing.T) {
+	factory := newFakeKvstoreFactory()
+	factory.setWatchDelay(500 * time.Millisecond)
+
+	recorder := &eventRecorder{}
+	cm := setupFakeClusterMesh(t, factory, func(string, StatusFunc) RemoteCluster { return &watchingRemoteCluster{} }, Config{})
+	cm.RegisterEventSink(recorder)
+	hivetest.Lifecycle(t).Append(cm)
+
+	// The connection gets established immediately, but the initialization
+	// does not complete until the delayed watch returned the initial events.
+	waitForCluster(t, cm, "cluster1", true)
+	require.Zero(t, recorder.count(LifecycleEventSyncCompleted))
+	require.Eventually(t, func() bool {
+		return recorder.count(LifecycleEventSyncCompleted) > 0
+	}, timeout, tick)
+}
@@ -7,6 +7,7 @@ import (
 	"maps"
 
 	"github.com/sirupsen/logrus"
+	k8sLabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -189,29 +190,75 @@ func (c *GlobalServiceCache) Size() (num int) {
 	return
 }
 
+// ServiceFilter restricts the services imported from a remote cluster to the
+// ones belonging to the given namespaces and matching the given label
+// selector, to reduce memory and LB map usage in large meshes where only a
+// few services are shared. An empty filter imports all shared services.
+type ServiceFilter struct {
+	namespaces sets.Set[string]
+	selector   k8sLabels.Selector
+}
+
+// enabled returns whether the filter restricts the imported services.
+func (sf ServiceFilter) enabled() bool {
+	return sf.namespaces.Len() > 0 || sf.selector != nil
+}
+
+// matches returns whether the given service passes the filter.
+func (sf ServiceFilter) matches(svc *serviceStore.ClusterService) bool {
+	if sf.namespaces.Len() > 0 && !sf.namespaces.Has(svc.Namespace) {
+		return false
+	}
+
+	if sf.selector != nil && !sf.selector.Matches(k8sLabels.Set(svc.Labels)) {
+		return false
+	}
+
+	return true
+}
+
 type remoteServiceObserver struct {
 	log logrus.FieldLogger
 
 	cache *GlobalServiceCache
 
+	filter ServiceFilter
+
 	onUpdate func(*serviceStore.ClusterService)
 	onDelete func(*serviceStore.ClusterService)
 }
 
+// SharedServicesObserverOpt is an option to customize the behavior of the
+// shared services observer.
+type SharedServicesObserverOpt func(*remoteServiceObserver)
+
+// WithServiceFilter restricts the imported services to the ones matching the
+// given filter, treating the others as if they were not shared.
+func WithServiceFilter(filter ServiceFilter) SharedServicesObserverOpt {
+	return func(o *remoteServiceObserver) { o.filter = filter }
+}
+
 // NewSharedServicesObserver returns an observer implementing the logic to convert
 // and filter shared services notifications, update the global service cache and
 // call the upstream handlers when appropriate.
 func NewSharedServicesObserver(
 	log logrus.FieldLogger, cache *GlobalServiceCache,
 	onUpdate, onDelete func(*serviceStore.ClusterService),
+	opts ...SharedServicesObserverOpt,
 ) store.Observer {
-	return &remoteServiceObserver{
+	observer := &remoteServiceObserver{
 		log:   log,
 		cache: cache,
 
 		onUpdate: onUpdate,
 		onDelete: onDelete,
 	}
+
+	for _, opt := range opts {
+		opt(observer)
+	}
+
+	return observer
 }
 
 // OnUpdate is called when a service in a remote cluster is updated
@@ -220,13 +267,13 @@ func (r *remoteServiceObserver) OnUpdate(key store.Key) {
 	scopedLog := r.log.WithFields(logrus.Fields{logfields.ServiceName: svc.String()})
 	scopedLog.Debug("Received remote service update event")
 
-	// Short-circuit the handling of non-shared services
-	if !svc.Shared {
+	// Short-circuit the handling of non-shared and filtered services
+	if !svc.Shared || (r.filter.enabled() && !r.filter.matches(svc)) {
 		if r.cache.Has(svc) {
-			scopedLog.Debug("Previously shared service is no longer shared: triggering deletion event")
+			scopedLog.Debug("Previously imported service is no longer shared or matching the import filter: triggering deletion event")
 			r.OnDelete(key)
 		} else {
-			scopedLog.Debug("Ignoring remote service update: service is not shared")
+			scopedLog.Debug("Ignoring remote service update: service is not shared or does not match the import filter")
 		}
 		return
 	}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cilium/cilium/pkg/clustermesh/types"
+	cmutils "github.com/cilium/cilium/pkg/clustermesh/utils"
+	"github.com/cilium/cilium/pkg/kvstore"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+// validationTimeout bounds the overall duration of the dry-run validation of
+// a remote cluster configuration, so that an unreachable etcd cluster does
+// not block the caller forever.
+const validationTimeout = 1 * time.Minute
+
+// ValidateCluster performs a dry-run validation of the given clustermesh
+// configuration: it establishes a short-lived connection to the target etcd
+// cluster, retrieves the advertised cluster configuration and verifies its
+// compatibility with the local cluster, without registering the remote
+// cluster. The retrieved configuration is returned to allow reporting it,
+// possibly along with the validation error.
+func (cm *clusterMesh) ValidateCluster(ctx context.Context, name, configPath string) (types.CiliumClusterConfig, error) {
+	if name == cm.conf.ClusterInfo.Name {
+		return types.CiliumClusterConfig{}, fmt.Errorf("cluster name %q matches that of the local cluster", name)
+	}
+
+	if err := types.ValidateClusterName(name); err != nil {
+		return types.CiliumClusterConfig{}, fmt.Errorf("invalid cluster name: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, validationTimeout)
+	defer cancel()
+
+	if eps, err := kvstore.EtcdEndpoints(configPath); err == nil {
+		if _, err := selectEndpointFamilies(eps, option.Config.IPv4Enabled(), option.Config.IPv6Enabled()); err != nil {
+			return types.CiliumClusterConfig{}, err
+		}
+	}
+
+	opts := map[string]string{kvstore.EtcdOptionConfig: configPath}
+	extraOpts := kvstore.ExtraOptions{
+		NoLockQuorumCheck:      true,
+		ClusterName:            name,
+		NoEndpointStatusChecks: true,
+	}
+
+	var (
+		backend kvstore.BackendOperations
+		errChan chan error
+	)
+
+	if cm.conf.RemoteClientFactory != nil {
+		backend, errChan = cm.conf.RemoteClientFactory(ctx, opts, &extraOpts)
+	} else {
+		backend, errChan = kvstore.NewClient(ctx, kvstore.EtcdBackendName, opts, &extraOpts)
+	}
+
+	// Block until either an error is returned or the channel is closed due
+	// to success of the connection.
+	if err := <-errChan; err != nil {
+		if backend != nil {
+			backend.Close(ctx)
+		}
+		return types.CiliumClusterConfig{}, fmt.Errorf("unable to establish etcd connection: %w", err)
+	}
+	defer backend.Close(ctx)
+
+	config, err := cmutils.GetClusterConfig(ctx, name, backend)
+	if err != nil {
+		return types.CiliumClusterConfig{}, fmt.Errorf("unable to retrieve cluster configuration: %w", err)
+	}
+
+	if config.ID == cm.conf.ClusterInfo.ID {
+		return config, fmt.Errorf("cluster ID %d is already used by the local cluster: %w",
+			config.ID, types.ErrClusterConfigConflict)
+	}
+
+	if err := cm.conf.ClusterInfo.ValidateRemoteConfig(config); err != nil {
+		return config, fmt.Errorf("incompatible cluster configuration: %w", err)
+	}
+
+	return config, nil
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"path"
 
+	"github.com/go-openapi/strfmt"
+
 	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/clustermesh/common"
 	"github.com/cilium/cilium/pkg/clustermesh/types"
@@ -64,6 +66,13 @@ func (rc *remoteCluster) Run(ctx context.Context, backend kvstore.BackendOperati
 	mgr.Run(ctx)
 }
 
+// WaitForSync waits until the initial synchronization of the shared services
+// from the remote cluster has completed, the remote cluster is disconnected,
+// or the given context is canceled.
+func (rc *remoteCluster) WaitForSync(ctx context.Context) error {
+	return rc.synced.Services(ctx)
+}
+
 func (rc *remoteCluster) Stop() {
 	rc.synced.Stop()
 }
@@ -103,7 +112,8 @@ func (rc *remoteCluster) Status() *models.RemoteCluster {
 	status.NumSharedServices = int64(rc.remoteServices.NumEntries())
 
 	status.Synced = &models.RemoteClusterSynced{
-		Services: rc.remoteServices.Synced(),
+		Services:         rc.remoteServices.Synced(),
+		ServicesLastSync: strfmt.DateTime(rc.remoteServices.SyncedAt()),
 		// The operator does not watch nodes, endpoints and identities, hence
 		// let's pretend them to be synchronized by default.
 		Nodes:      true,
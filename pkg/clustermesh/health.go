@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package clustermesh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cilium/hive/cell"
+	"github.com/cilium/hive/job"
+
+	"github.com/cilium/cilium/api/v1/models"
+	"github.com/cilium/cilium/pkg/clustermesh/common"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+// healthReportInterval is the interval between the periodic re-evaluations of
+// the per-cluster status reported into the modular health tree.
+const healthReportInterval = 15 * time.Second
+
+type healthReporterParams struct {
+	cell.In
+
+	ClusterMesh *ClusterMesh `optional:"true"`
+	Config      common.Config
+	JobGroup    job.Group
+	Health      cell.Health
+}
+
+// registerHealthReporter periodically reports the readiness and initial
+// synchronization status of each remote cluster into the modular health tree,
+// so that missing clustermesh connectivity surfaces through the agent health
+// probes. When the readiness gate is enabled, the reporter job itself is
+// additionally marked as degraded until all remote clusters are ready and
+// synchronized, allowing orchestration to hold rollouts in the meanwhile.
+func registerHealthReporter(p healthReporterParams) {
+	if p.ClusterMesh == nil {
+		return
+	}
+
+	reporters := make(map[string]cell.Health)
+
+	p.JobGroup.Add(job.Timer("clustermesh-health-reporter", func(ctx context.Context) error {
+		var notReady []string
+		seen := make(map[string]struct{})
+
+		p.ClusterMesh.common.ForEachRemoteCluster(func(rci common.RemoteCluster) error {
+			rc := rci.(*remoteCluster)
+			seen[rc.name] = struct{}{}
+
+			hr, ok := reporters[rc.name]
+			if !ok {
+				hr = p.Health.NewScope(rc.name)
+				reporters[rc.name] = hr
+			}
+
+			status := rc.Status()
+			switch {
+			case !status.Ready:
+				hr.Degraded("Connection to remote cluster not ready", errors.New(status.Status))
+				notReady = append(notReady, rc.name)
+			case !isFullySynced(status.Synced):
+				hr.Degraded("Initial synchronization from remote cluster not completed", nil)
+				notReady = append(notReady, rc.name)
+			default:
+				hr.OK("Connected and synchronized")
+			}
+
+			return nil
+		})
+
+		// Drop the reporters associated with disconnected clusters.
+		for name, hr := range reporters {
+			if _, ok := seen[name]; !ok {
+				hr.Close()
+				delete(reporters, name)
+			}
+		}
+
+		if len(notReady) > 0 && p.Config.ClusterMeshReadinessGate {
+			return fmt.Errorf("remote clusters not ready: %s", strings.Join(notReady, ", "))
+		}
+
+		return nil
+	}, healthReportInterval))
+}
+
+// isFullySynced returns whether the initial synchronization of all resource
+// types from the given remote cluster has completed.
+func isFullySynced(synced *models.RemoteClusterSynced) bool {
+	return synced != nil && synced.Nodes && synced.Services && synced.Identities && synced.Endpoints
+}
@@ -58,14 +58,14 @@ func (cm *ClusterMeshUsedIDs) ReserveClusterID(clusterID uint32) error {
 	}
 
 	if clusterID == cm.localClusterID {
-		return fmt.Errorf("clusterID %d is assigned to the local cluster", clusterID)
+		return fmt.Errorf("clusterID %d is assigned to the local cluster: %w", clusterID, cmtypes.ErrClusterConfigConflict)
 	}
 
 	cm.UsedClusterIDsMutex.Lock()
 	defer cm.UsedClusterIDsMutex.Unlock()
 
 	if _, ok := cm.UsedClusterIDs[clusterID]; ok {
-		return fmt.Errorf("clusterID %d is already used", clusterID)
+		return fmt.Errorf("clusterID %d is already used by another remote cluster: %w", clusterID, cmtypes.ErrClusterConfigConflict)
 	}
 
 	cm.UsedClusterIDs[clusterID] = struct{}{}
@@ -359,6 +359,22 @@ func (m *mockClusterMesh) NumReadyClusters() int {
 	return len(m.clusters)
 }
 
+func (m *mockClusterMesh) UseCluster(name string) {}
+
+func (m *mockClusterMesh) DisconnectCluster(name string, mode common.DrainMode) {}
+
+func (m *mockClusterMesh) ConnectCluster(name, configPath string) error { return nil }
+
+func (m *mockClusterMesh) ValidateCluster(ctx context.Context, name, configPath string) (types.CiliumClusterConfig, error) {
+	return types.CiliumClusterConfig{}, nil
+}
+
+func (m *mockClusterMesh) PauseCluster(name string) {}
+
+func (m *mockClusterMesh) RegisterEventSink(sink common.LifecycleEventSink) {}
+
+func (m *mockClusterMesh) ResumeCluster(name string) {}
+
 func (m *mockClusterMesh) Start(cell.HookContext) error {
 	return nil
 }
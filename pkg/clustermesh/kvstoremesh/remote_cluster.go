@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 
 	"github.com/cilium/cilium/api/v1/models"
@@ -123,6 +124,13 @@ func (rc *remoteCluster) Run(ctx context.Context, backend kvstore.BackendOperati
 	mgr.Run(ctx)
 }
 
+// WaitForSync waits until the initial synchronization of all resource types
+// from the remote cluster has completed, the remote cluster is disconnected,
+// or the given context is canceled.
+func (rc *remoteCluster) WaitForSync(ctx context.Context) error {
+	return rc.synced.Resources(ctx)
+}
+
 func (rc *remoteCluster) Stop() {
 	rc.cancel()
 	rc.synced.Stop()
@@ -167,6 +175,11 @@ func (rc *remoteCluster) Status() *models.RemoteCluster {
 		Services:   rc.services.watcher.Synced(),
 		Identities: rc.identities.watcher.Synced(),
 		Endpoints:  rc.ipcache.watcher.Synced(),
+
+		NodesLastSync:      strfmt.DateTime(rc.nodes.watcher.SyncedAt()),
+		ServicesLastSync:   strfmt.DateTime(rc.services.watcher.SyncedAt()),
+		IdentitiesLastSync: strfmt.DateTime(rc.identities.watcher.SyncedAt()),
+		EndpointsLastSync:  strfmt.DateTime(rc.ipcache.watcher.SyncedAt()),
 	}
 
 	status.Ready = status.Ready &&
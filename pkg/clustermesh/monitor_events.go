@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package clustermesh
+
+import (
+	"github.com/cilium/hive/cell"
+
+	"github.com/cilium/cilium/pkg/clustermesh/common"
+	monitorAgent "github.com/cilium/cilium/pkg/monitor/agent"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
+)
+
+// monitorNotify is an interface to notify the monitor about remote cluster
+// lifecycle events.
+type monitorNotify interface {
+	SendEvent(typ int, event interface{}) error
+}
+
+// monitorEventSink forwards remote cluster lifecycle events as agent
+// notifications, so that cross-cluster connectivity incidents surface
+// through Hubble in the same pipeline as flows.
+type monitorEventSink struct {
+	monitorNotify monitorNotify
+}
+
+// HandleLifecycleEvent implements common.LifecycleEventSink. Events are
+// emitted on a best effort basis, as the monitor drops them when no
+// listener is connected.
+func (s *monitorEventSink) HandleLifecycleEvent(event common.LifecycleEvent) {
+	msg := monitorAPI.ClusterMeshEventMessage(event.Cluster, string(event.Type), event.Message)
+	s.monitorNotify.SendEvent(monitorAPI.MessageTypeAgent, msg)
+}
+
+type monitorEventSinkParams struct {
+	cell.In
+
+	ClusterMesh *ClusterMesh `optional:"true"`
+	Monitor     monitorAgent.Agent
+}
+
+// registerMonitorEventSink registers the sink forwarding the remote cluster
+// lifecycle events to the monitor, and in turn to Hubble.
+func registerMonitorEventSink(p monitorEventSinkParams) {
+	if p.ClusterMesh == nil {
+		return
+	}
+
+	p.ClusterMesh.common.RegisterEventSink(&monitorEventSink{monitorNotify: p.Monitor})
+}
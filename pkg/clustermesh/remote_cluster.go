@@ -7,6 +7,8 @@ import (
 	"context"
 	"path"
 
+	"github.com/go-openapi/strfmt"
+
 	"github.com/cilium/cilium/api/v1/models"
 	"github.com/cilium/cilium/pkg/allocator"
 	"github.com/cilium/cilium/pkg/clustermesh/common"
@@ -61,6 +63,9 @@ type remoteCluster struct {
 	// allocations in the remote cluster
 	remoteIdentityCache *allocator.RemoteCache
 
+	// scope restricts the resource types consumed from the remote cluster.
+	scope common.ResourceScope
+
 	// status is the function which fills the common part of the status.
 	status common.StatusFunc
 
@@ -83,17 +88,19 @@ func (rc *remoteCluster) Run(ctx context.Context, backend kvstore.BackendOperati
 		return
 	}
 
-	remoteIdentityCache, err := rc.remoteIdentityWatcher.WatchRemoteIdentities(rc.name, rc.clusterID, backend, config.Capabilities.Cached)
-	if err != nil {
-		ready <- err
-		close(ready)
-		return
+	if rc.scope.Identities {
+		remoteIdentityCache, err := rc.remoteIdentityWatcher.WatchRemoteIdentities(rc.name, rc.clusterID, backend, config.Capabilities.Cached)
+		if err != nil {
+			ready <- err
+			close(ready)
+			return
+		}
+
+		rc.mutex.Lock()
+		rc.remoteIdentityCache = remoteIdentityCache
+		rc.mutex.Unlock()
 	}
 
-	rc.mutex.Lock()
-	rc.remoteIdentityCache = remoteIdentityCache
-	rc.mutex.Unlock()
-
 	var mgr store.WatchStoreManager
 	if config.Capabilities.SyncedCanaries {
 		mgr = rc.storeFactory.NewWatchStoreManager(backend, rc.name)
@@ -106,26 +113,46 @@ func (rc *remoteCluster) Run(ctx context.Context, backend kvstore.BackendOperati
 		adapter = kvstore.StateToCachePrefix
 	}
 
-	mgr.Register(adapter(nodeStore.NodeStorePrefix), func(ctx context.Context) {
-		rc.remoteNodes.Watch(ctx, backend, path.Join(adapter(nodeStore.NodeStorePrefix), rc.name))
-	})
+	if rc.scope.Nodes {
+		mgr.Register(adapter(nodeStore.NodeStorePrefix), func(ctx context.Context) {
+			rc.remoteNodes.Watch(ctx, backend, path.Join(adapter(nodeStore.NodeStorePrefix), rc.name))
+		})
+	}
 
-	mgr.Register(adapter(serviceStore.ServiceStorePrefix), func(ctx context.Context) {
-		rc.remoteServices.Watch(ctx, backend, path.Join(adapter(serviceStore.ServiceStorePrefix), rc.name))
-	})
+	if rc.scope.Services {
+		mgr.Register(adapter(serviceStore.ServiceStorePrefix), func(ctx context.Context) {
+			rc.remoteServices.Watch(ctx, backend, path.Join(adapter(serviceStore.ServiceStorePrefix), rc.name))
+		})
+	}
 
-	mgr.Register(adapter(ipcache.IPIdentitiesPath), func(ctx context.Context) {
-		rc.ipCacheWatcher.Watch(ctx, backend, rc.ipCacheWatcherOpts(&config)...)
-	})
+	if rc.scope.IPCache {
+		mgr.Register(adapter(ipcache.IPIdentitiesPath), func(ctx context.Context) {
+			rc.ipCacheWatcher.Watch(ctx, backend, rc.ipCacheWatcherOpts(&config)...)
+		})
+	}
 
-	mgr.Register(adapter(identityCache.IdentitiesPath), func(ctx context.Context) {
-		rc.remoteIdentityCache.Watch(ctx, func(context.Context) { rc.synced.identities.Done() })
-	})
+	if rc.scope.Identities {
+		mgr.Register(adapter(identityCache.IdentitiesPath), func(ctx context.Context) {
+			rc.remoteIdentityCache.Watch(ctx, func(context.Context) { rc.synced.identities.Done() })
+		})
+	}
 
 	close(ready)
 	mgr.Run(ctx)
 }
 
+// WaitForSync waits until the initial synchronization of all resource types
+// from the remote cluster has completed, the remote cluster is disconnected,
+// or the given context is canceled.
+func (rc *remoteCluster) WaitForSync(ctx context.Context) error {
+	for _, waiter := range []wait.Fn{rc.synced.Nodes, rc.synced.Services, rc.synced.IPIdentities} {
+		if err := waiter(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (rc *remoteCluster) Stop() {
 	rc.synced.Stop()
 }
@@ -159,11 +186,18 @@ func (rc *remoteCluster) Status() *models.RemoteCluster {
 		Services:   rc.remoteServices.Synced(),
 		Identities: rc.remoteIdentityCache.Synced(),
 		Endpoints:  rc.ipCacheWatcher.Synced(),
+
+		NodesLastSync:      strfmt.DateTime(rc.remoteNodes.SyncedAt()),
+		ServicesLastSync:   strfmt.DateTime(rc.remoteServices.SyncedAt()),
+		IdentitiesLastSync: strfmt.DateTime(rc.remoteIdentityCache.SyncedAt()),
+		EndpointsLastSync:  strfmt.DateTime(rc.ipCacheWatcher.SyncedAt()),
 	}
 
 	status.Ready = status.Ready &&
-		status.Synced.Nodes && status.Synced.Services &&
-		status.Synced.Identities && status.Synced.Endpoints
+		(status.Synced.Nodes || !rc.scope.Nodes) &&
+		(status.Synced.Services || !rc.scope.Services) &&
+		(status.Synced.Identities || !rc.scope.Identities) &&
+		(status.Synced.Endpoints || !rc.scope.IPCache)
 
 	return status
 }
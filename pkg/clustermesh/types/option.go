@@ -94,7 +94,14 @@ func (c ClusterInfo) ValidateRemoteConfig(config CiliumClusterConfig) error {
 	}
 
 	if c.ExtendedClusterMeshEnabled() && (c.MaxConnectedClusters != config.Capabilities.MaxConnectedClusters) {
-		return fmt.Errorf("mismatched MaxConnectedClusters; local=%d, remote=%d", c.MaxConnectedClusters, config.Capabilities.MaxConnectedClusters)
+		return fmt.Errorf("mismatched MaxConnectedClusters; local=%d, remote=%d: %w",
+			c.MaxConnectedClusters, config.Capabilities.MaxConnectedClusters, ErrIncompatibleCapabilities)
+	}
+
+	for _, feature := range config.Capabilities.RequiredFeatures {
+		if _, ok := SupportedFeatures[feature]; !ok {
+			return fmt.Errorf("remote cluster requires unsupported feature %q: %w", feature, ErrIncompatibleCapabilities)
+		}
 	}
 
 	return nil
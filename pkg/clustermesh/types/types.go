@@ -35,6 +35,16 @@ const (
 
 var clusterNameRegex = regexp.MustCompile(clusterNameRegexStr)
 
+// ErrClusterConfigConflict is the error returned when the configuration
+// advertised by a remote cluster conflicts with that of the local cluster
+// or of another connected remote cluster (e.g., colliding cluster IDs).
+var ErrClusterConfigConflict = errors.New("remote cluster configuration conflict")
+
+// ErrIncompatibleCapabilities is the error returned when the capabilities
+// advertised by a remote cluster are incompatible with the local cluster
+// (e.g., it requires a feature that this implementation does not support).
+var ErrIncompatibleCapabilities = errors.New("incompatible remote cluster capabilities")
+
 // InitClusterIDMax validates and sets the ClusterIDMax package level variable.
 func (c ClusterInfo) InitClusterIDMax() error {
 	switch c.MaxConnectedClusters {
@@ -94,4 +104,38 @@ type CiliumClusterConfigCapabilities struct {
 
 	// The maximum number of clusters the given cluster can support in a ClusterMesh.
 	MaxConnectedClusters uint32 `json:"maxConnectedClusters,omitempty"`
+
+	// The values of the high-volume prefixes (i.e., ipcache and identities)
+	// are stored compressed, and readers must support transparent
+	// decompression.
+	CompressedSync bool `json:"compressedSync,omitempty"`
+
+	// RequiredFeatures are the features that the given cluster requires its
+	// peers to support to correctly interpret the shared data. A reader must
+	// refuse the connection when it does not support one of the advertised
+	// features, enabling forward-compatible capability negotiation.
+	RequiredFeatures []string `json:"requiredFeatures,omitempty"`
+}
+
+// Feature names that may be advertised as required through the cluster
+// configuration.
+const (
+	// FeatureSyncedCanaries indicates support for per-prefix "synced" canaries.
+	FeatureSyncedCanaries = "synced-canaries"
+
+	// FeatureCachedCluster indicates support for reading the information
+	// concerning the given cluster from the "cilium/cache" prefix.
+	FeatureCachedCluster = "cached"
+
+	// FeatureCompressedSync indicates support for transparent decompression
+	// of the values of the high-volume prefixes.
+	FeatureCompressedSync = "compressed-sync"
+)
+
+// SupportedFeatures is the set of features that this implementation supports
+// as a reader of the data shared by remote clusters.
+var SupportedFeatures = map[string]struct{}{
+	FeatureSyncedCanaries: {},
+	FeatureCachedCluster:  {},
+	FeatureCompressedSync: {},
 }
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/rand"
 
 	"github.com/cilium/hive/cell"
 	"github.com/sirupsen/logrus"
@@ -101,6 +102,13 @@ type ControllerParams struct {
 	// constant back off. The default is 1s.
 	ErrorRetryBaseDuration time.Duration
 
+	// RetryJitter is the maximum fraction of the error retry interval
+	// that is additionally applied as random jitter (e.g. 0.1 adds up to
+	// 10%). It is applied after MaxRetryInterval capping, so that
+	// multiple instances retrying against the same failing target do not
+	// synchronize once the cap is reached.
+	RetryJitter float64
+
 	// NoErrorRetry when set to true, disabled retries on errors
 	NoErrorRetry bool
 
@@ -297,6 +305,10 @@ func (c *controller) runController(params ControllerParams) {
 						interval = params.MaxRetryInterval
 					}
 
+					if params.RetryJitter > 0 {
+						interval += time.Duration(float64(interval) * params.RetryJitter * rand.Float64())
+					}
+
 					errorRetries++
 				}
 			}
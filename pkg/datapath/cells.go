@@ -171,9 +171,10 @@ func newDatapath(params datapathParams) types.Datapath {
 		DB:             params.DB,
 		Devices:        params.Devices,
 		Orchestrator:   params.Orchestrator,
-		NodeHandler:    params.NodeHandler,
-		NodeIDHandler:  params.NodeIDHandler,
-		NodeNeighbors:  params.NodeNeighbors,
+		NodeHandler:     params.NodeHandler,
+		NodeIDHandler:   params.NodeIDHandler,
+		NodeNeighbors:   params.NodeNeighbors,
+		BackendSelector: params.BackendSelector,
 	})
 
 	params.LC.Append(cell.Hook{
@@ -228,4 +229,9 @@ type datapathParams struct {
 	NodeIDHandler types.NodeIDHandler
 
 	NodeNeighbors types.NodeNeighbors
+
+	// BackendSelector is an optional custom backend selection/ordering
+	// strategy, allowing downstream builds to plug their own scheduler
+	// into the backend slot programming.
+	BackendSelector types.BackendSelector `optional:"true"`
 }
@@ -8,9 +8,11 @@ import (
 
 	"github.com/cilium/cilium/pkg/datapath/tables"
 	datapath "github.com/cilium/cilium/pkg/datapath/types"
+	"github.com/cilium/cilium/pkg/debug"
 	"github.com/cilium/cilium/pkg/maps/lbmap"
 	"github.com/cilium/cilium/pkg/maps/nodemap"
 	"github.com/cilium/cilium/pkg/node/manager"
+	"github.com/cilium/cilium/pkg/option"
 )
 
 // DatapathConfiguration is the static configuration of the datapath. The
@@ -53,17 +55,27 @@ type DatapathParams struct {
 	NodeHandler    datapath.NodeHandler
 	NodeIDHandler  datapath.NodeIDHandler
 	NodeNeighbors  datapath.NodeNeighbors
+
+	// BackendSelector is an optional custom backend selection strategy.
+	BackendSelector datapath.BackendSelector
 }
 
 // NewDatapath creates a new Linux datapath
 func NewDatapath(p DatapathParams) datapath.Datapath {
+	lbm := datapath.LBMap(lbmap.New(p.BackendSelector))
+	if option.Config.Debug {
+		tracing := lbmap.NewTracingLBMap(lbm)
+		debug.RegisterStatusObject("lbmap-ops-trace", tracing)
+		lbm = tracing
+	}
+
 	dp := &linuxDatapath{
 		ConfigWriter:    p.ConfigWriter,
 		IptablesManager: p.RuleManager,
 		nodeAddressing:  p.NodeAddressing,
 		loader:          p.Loader,
 		wgAgent:         p.WGAgent,
-		lbmap:           lbmap.New(),
+		lbmap:           lbm,
 		bwmgr:           p.BWManager,
 		orchestrator:    p.Orchestrator,
 		nodeHandler:     p.NodeHandler,
@@ -36,6 +36,7 @@ import (
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/maps/nodemap"
 	"github.com/cilium/cilium/pkg/maps/tunnel"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
 	"github.com/cilium/cilium/pkg/node"
 	"github.com/cilium/cilium/pkg/node/manager"
 	nodeTypes "github.com/cilium/cilium/pkg/node/types"
@@ -684,6 +685,8 @@ func (n *linuxNodeHandler) insertNeighborCommon(ctx context.Context, nextHop Nex
 	}
 	n.neighByNextHop[nextHop.Name] = &neigh
 
+	route.NotifyMonitor(monitorAPI.NeighborUpsertedMessage(nextHop.IP.String(), link.Attrs().Name, "node-neigh", "next hop discovery"))
+
 	return errs
 }
 
@@ -896,6 +899,8 @@ func (n *linuxNodeHandler) deleteNeighborCommon(nextHopStr string) {
 					logfields.NextHop:   neigh.IP,
 					logfields.LinkIndex: neigh.LinkIndex,
 				}).WithError(err).Info("Unable to remove next hop")
+			} else {
+				route.NotifyMonitor(monitorAPI.NeighborDeletedMessage(neigh.IP.String(), "", "node-neigh", "next hop removed"))
 			}
 		}
 	}
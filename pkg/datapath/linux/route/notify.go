@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package route
+
+import (
+	"github.com/cilium/cilium/pkg/lock"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
+)
+
+var (
+	notifyMutex lock.RWMutex
+	notifyFunc  func(msg monitorAPI.AgentNotifyMessage)
+)
+
+// SetMonitorNotify registers the function used to emit agent notifications
+// for route, rule and neighbor programming decisions. It is typically set
+// once by the daemon after the monitor agent has been initialized. Passing
+// nil disables notifications again.
+func SetMonitorNotify(fn func(msg monitorAPI.AgentNotifyMessage)) {
+	notifyMutex.Lock()
+	notifyFunc = fn
+	notifyMutex.Unlock()
+}
+
+// NotifyMonitor emits the given agent notification if a notification
+// function has been registered via SetMonitorNotify. It is exported so that
+// other datapath subsystems programming neighbor entries can share the same
+// notification channel.
+func NotifyMonitor(msg monitorAPI.AgentNotifyMessage) {
+	notifyMutex.RLock()
+	fn := notifyFunc
+	notifyMutex.RUnlock()
+	if fn != nil {
+		fn(msg)
+	}
+}
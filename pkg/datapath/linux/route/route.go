@@ -24,6 +24,11 @@ type Route struct {
 	Scope    netlink.Scope
 	Table    int
 	Type     int
+
+	// Owner is an optional name of the subsystem requesting the route. It
+	// is only used to annotate monitor notifications and is not part of
+	// the route installed in the kernel.
+	Owner string
 }
 
 // LogFields returns the route attributes as logrus.Fields map
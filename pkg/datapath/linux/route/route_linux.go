@@ -14,6 +14,7 @@ import (
 	"golang.org/x/sys/unix"
 
 	"github.com/cilium/cilium/pkg/datapath/linux/linux_defaults"
+	monitorAPI "github.com/cilium/cilium/pkg/monitor/api"
 	"github.com/cilium/cilium/pkg/time"
 )
 
@@ -283,6 +284,8 @@ func Upsert(route Route) error {
 		return err
 	}
 
+	NotifyMonitor(monitorAPI.RouteUpsertedMessage(route.Prefix.String(), route.Device, route.Table, route.Owner, ""))
+
 	return nil
 }
 
@@ -311,6 +314,8 @@ func Delete(route Route) error {
 		return err
 	}
 
+	NotifyMonitor(monitorAPI.RouteDeletedMessage(route.Prefix.String(), route.Device, route.Table, route.Owner, ""))
+
 	return nil
 }
 
@@ -486,7 +491,11 @@ func replaceRule(spec Rule, family int) error {
 	rule.Src = spec.From
 	rule.Dst = spec.To
 	rule.Protocol = spec.Protocol
-	return netlink.RuleAdd(rule)
+	if err := netlink.RuleAdd(rule); err != nil {
+		return err
+	}
+	NotifyMonitor(monitorAPI.RuleUpsertedMessage(spec.String(), spec.Table, "", ""))
+	return nil
 }
 
 // DeleteRule delete a mark based rule from the routing table.
@@ -500,7 +509,11 @@ func DeleteRule(family int, spec Rule) error {
 	rule.Dst = spec.To
 	rule.Family = family
 	rule.Protocol = spec.Protocol
-	return netlink.RuleDel(rule)
+	if err := netlink.RuleDel(rule); err != nil {
+		return err
+	}
+	NotifyMonitor(monitorAPI.RuleDeletedMessage(spec.String(), spec.Table, "", ""))
+	return nil
 }
 
 func lookupDefaultRoute(family int) (netlink.Route, error) {
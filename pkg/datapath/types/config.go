@@ -80,6 +80,10 @@ type CompileTimeConfiguration interface {
 	// GetPolicyVerdictLogFilter returns the PolicyVerdictLogFilter for the endpoint
 	GetPolicyVerdictLogFilter() uint32
 
+	// GetDSCPEgress returns the DSCP mark to set on traffic leaving the
+	// endpoint, or zero if no mark is configured
+	GetDSCPEgress() uint8
+
 	// IsHost returns true if the endpoint is the host endpoint.
 	IsHost() bool
 }
@@ -22,12 +22,32 @@ type LBMap interface {
 	DeleteBackendByID(loadbalancer.BackendID) error
 	AddAffinityMatch(uint16, loadbalancer.BackendID) error
 	DeleteAffinityMatch(uint16, loadbalancer.BackendID) error
-	UpdateSourceRanges(uint16, []*cidr.CIDR, []*cidr.CIDR, bool) error
+	UpdateSourceRanges(uint16, []*cidr.CIDR, bool) (uint16, error)
+	DeleteSourceRanges(uint16, []*cidr.CIDR, bool) error
+	RestoreSourceRangeSets(SourceRangeSetByServiceID, bool)
+	PruneSourceRangeSets(bool) error
 	DumpServiceMaps() ([]*loadbalancer.SVC, []error)
 	DumpBackendMaps() ([]*loadbalancer.Backend, error)
 	DumpAffinityMatches() (BackendIDByServiceIDSet, error)
 	DumpSourceRanges(bool) (SourceRangeSetByServiceID, error)
 	ExistsSockRevNat(cookie uint64, addr net.IP, port uint16) bool
+	PruneSockRevNat(knownRevNatIDs map[uint16]struct{}) (int, error)
+	PruneSessionAffinity(timeouts map[uint16]uint32) (int, error)
+}
+
+// BackendSelector is the interface to plug a custom backend selection or
+// ordering strategy (e.g. cost or latency aware) into the backend slot
+// programming. An implementation can be registered as an optional dependency
+// of the datapath cell; if none is registered, the default ordering of
+// GetOrderedBackends is used.
+type BackendSelector interface {
+	// OrderBackends returns the backend IDs of the given service in the
+	// order in which they are to be programmed into the backend slots.
+	// The default order, with preferred backends first followed by active
+	// and non-active ones sorted by ID, is passed in and may be returned
+	// unmodified. Non-active backends must remain at the tail of the
+	// returned slice to be excluded from selection.
+	OrderBackends(p *UpsertServiceParams, defaultOrder []loadbalancer.BackendID) []loadbalancer.BackendID
 }
 
 type UpsertServiceParams struct {
@@ -50,6 +70,7 @@ type UpsertServiceParams struct {
 	SessionAffinity           bool
 	SessionAffinityTimeoutSec uint32
 	CheckSourceRange          bool
+	SourceRangeSetID          uint16 // Shared source range set keying the LPM entries
 	UseMaglev                 bool
 	L7LBProxyPort             uint16                   // Non-zero for L7 LB services
 	Name                      loadbalancer.ServiceName // Fully qualified name of the service
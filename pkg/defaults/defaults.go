@@ -441,6 +441,10 @@ const (
 	// per GC interval
 	ENIGarbageCollectionMaxPerInterval = 25
 
+	// ENILimitsUpdateInterval is the interval at which the instance type
+	// ENI/IP limits are refreshed from the EC2 API
+	ENILimitsUpdateInterval = 12 * time.Hour
+
 	// ParallelAllocWorkers is the default max number of parallel workers doing allocation in the operator
 	ParallelAllocWorkers = 50
 
@@ -1507,6 +1507,12 @@ func (e *Endpoint) GetPolicyVerdictLogFilter() uint32 {
 	return filter
 }
 
+// GetDSCPEgress returns the DSCP mark to set on traffic leaving the endpoint,
+// or zero if no mark is configured.
+func (e *Endpoint) GetDSCPEgress() uint8 {
+	return e.dscpEgress
+}
+
 type linkCheckerFunc func(string) error
 
 // ValidateConnectorPlumbing checks whether the endpoint is correctly plumbed.
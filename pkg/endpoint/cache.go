@@ -39,6 +39,7 @@ type epInfoCache struct {
 	requireRouting         bool
 	requireEndpointRoute   bool
 	policyVerdictLogFilter uint32
+	dscpEgress             uint8
 	options                *option.IntOptions
 	lxcMAC                 mac.MAC
 	ifIndex                int
@@ -70,6 +71,7 @@ func (e *Endpoint) createEpInfoCache(epdir string) *epInfoCache {
 		requireRouting:         e.RequireRouting(),
 		requireEndpointRoute:   e.RequireEndpointRoute(),
 		policyVerdictLogFilter: e.GetPolicyVerdictLogFilter(),
+		dscpEgress:             e.GetDSCPEgress(),
 		options:                e.Options.DeepCopy(),
 		lxcMAC:                 e.mac,
 		ifIndex:                e.ifIndex,
@@ -167,6 +169,12 @@ func (ep *epInfoCache) GetPolicyVerdictLogFilter() uint32 {
 	return ep.policyVerdictLogFilter
 }
 
+// GetDSCPEgress returns the DSCP mark to set on traffic leaving the endpoint,
+// or zero if no mark is configured.
+func (ep *epInfoCache) GetDSCPEgress() uint8 {
+	return ep.dscpEgress
+}
+
 func (ep *epInfoCache) IsHost() bool {
 	return ep.endpoint.IsHost()
 }
@@ -210,6 +210,10 @@ type Endpoint struct {
 	// bps is the egress rate of the endpoint
 	bps uint64
 
+	// dscpEgress is the DSCP mark set on traffic leaving the endpoint, zero
+	// if no mark is configured
+	dscpEgress uint8
+
 	// mac is the MAC address of the endpoint
 	// Constant after endpoint creation / restoration.
 	mac mac.MAC // Container MAC address.
@@ -1787,6 +1791,13 @@ func (e *Endpoint) metadataResolver(ctx context.Context,
 		}
 		return k8sMetadata.Annotations[bandwidth.EgressBandwidth], nil
 	})
+	e.UpdateDSCPPolicy(func(ns, podName string) (dscpEgress string, err error) {
+		_, k8sMetadata, err := resolveMetadata(ns, podName)
+		if err != nil {
+			return "", err
+		}
+		return k8sMetadata.Annotations[annotation.DSCPEgress], nil
+	})
 
 	// If 'baseLabels' are not set then 'controllerBaseLabels' only contains
 	// labels from k8s. Thus, we should only replace the labels that have their
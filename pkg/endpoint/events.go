@@ -11,6 +11,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/cilium/cilium/pkg/annotation"
 	"github.com/cilium/cilium/pkg/datapath/linux/bandwidth"
 	datapath "github.com/cilium/cilium/pkg/datapath/types"
 	"github.com/cilium/cilium/pkg/eventqueue"
@@ -329,6 +330,59 @@ func (ev *EndpointPolicyBandwidthEvent) Handle(res chan interface{}) {
 	}
 }
 
+// EndpointDSCPEvent contains all fields necessary to update the DSCP mark
+// set on traffic leaving the Pod.
+type EndpointDSCPEvent struct {
+	ep     *Endpoint
+	annoCB AnnotationsResolverCB
+}
+
+// Handle handles the DSCP mark update.
+func (ev *EndpointDSCPEvent) Handle(res chan interface{}) {
+	var dscp uint8
+
+	e := ev.ep
+	if err := e.lockAlive(); err != nil {
+		// If the endpoint is being deleted, we don't need to
+		// update its DSCP mark.
+		res <- &EndpointRegenerationResult{
+			err: nil,
+		}
+		return
+	}
+	defer func() {
+		e.unlock()
+	}()
+
+	dscpEgress, err := ev.annoCB(e.K8sNamespace, e.K8sPodName)
+	if err != nil {
+		res <- &EndpointRegenerationResult{
+			err: err,
+		}
+		return
+	}
+	if dscpEgress != "" {
+		value, err := strconv.ParseUint(dscpEgress, 10, 8)
+		if err == nil && value > 63 {
+			err = fmt.Errorf("DSCP value %d out of range [0, 63]", value)
+		}
+		if err != nil {
+			e.getLogger().WithError(err).Debugf("failed to parse DSCP mark %q", dscpEgress)
+		} else {
+			dscp = uint8(value)
+		}
+	}
+
+	if e.dscpEgress != dscp {
+		e.getLogger().Debugf("Updating %s from %d to %d", annotation.DSCPEgress,
+			e.dscpEgress, dscp)
+		e.dscpEgress = dscp
+	}
+	res <- &EndpointRegenerationResult{
+		err: nil,
+	}
+}
+
 // InitEventQueue initializes the endpoint's event queue. Note that this
 // function does not begin processing events off the queue, as that's left up
 // to the caller to call Expose in order to allow other subsystems to access
@@ -950,6 +950,27 @@ func (e *Endpoint) UpdateBandwidthPolicy(bwm dptypes.BandwidthManager, annoCB An
 	}
 }
 
+// UpdateDSCPPolicy updates the DSCP mark set on traffic leaving this endpoint
+// to reflect the state stored in the DSCP egress annotation. The mark is
+// compiled into the endpoint's datapath and thus only takes effect with the
+// next datapath regeneration.
+func (e *Endpoint) UpdateDSCPPolicy(annoCB AnnotationsResolverCB) {
+	ch, err := e.eventQueue.Enqueue(eventqueue.NewEvent(&EndpointDSCPEvent{
+		ep:     e,
+		annoCB: annoCB,
+	}))
+	if err != nil {
+		e.getLogger().WithError(err).Error("Unable to enqueue endpoint DSCP event")
+		return
+	}
+
+	updateRes := <-ch
+	regenResult, ok := updateRes.(*EndpointRegenerationResult)
+	if ok && regenResult.err != nil {
+		e.getLogger().WithError(regenResult.err).Error("EndpointDSCPEvent event failed")
+	}
+}
+
 // GetRealizedPolicyRuleLabelsForKey returns the list of policy rule labels
 // which match a given flow key (in host byte-order). The returned
 // LabelArrayList is shallow-copied and therefore must not be mutated.
@@ -84,6 +84,24 @@ type CachingIdentityAllocator struct {
 	// checkpointPath is the file where local allocator state should be checkpoointed.
 	// The default is /run/cilium/state/local_allocator_state.json, changed only for testing.
 	checkpointPath string
+
+	// remoteIdentityRelevance, if set, restricts the remote identities
+	// cached locally to the relevant ones; the others are filtered out, and
+	// fetched on demand from the remote kvstores upon lookup.
+	remoteIdentityRelevance IdentityRelevanceFunc
+}
+
+// IdentityRelevanceFunc returns whether the given remote identity is relevant
+// for the local cluster (i.e., referenced by local policy selectors).
+type IdentityRelevanceFunc func(id identity.NumericIdentity, lbls labels.LabelArray) bool
+
+// SetRemoteIdentityRelevance restricts the remote identities cached locally to
+// the ones for which the given function returns true, to reduce memory usage
+// in meshes with a large number of remote identities. The filtered identities
+// are fetched on demand from the remote kvstores upon lookup. It shall be
+// called before connecting remote clusters.
+func (m *CachingIdentityAllocator) SetRemoteIdentityRelevance(relevance IdentityRelevanceFunc) {
+	m.remoteIdentityRelevance = relevance
 }
 
 // IdentityAllocatorOwner is the interface the owner of an identity allocator
@@ -725,11 +743,16 @@ func (m *CachingIdentityAllocator) WatchRemoteIdentities(remoteName string, remo
 		return nil, fmt.Errorf("error setting up remote allocator backend: %w", err)
 	}
 
-	remoteAlloc, err := allocator.NewAllocator(&key.GlobalIdentity{}, remoteAllocatorBackend,
+	allocOpts := []allocator.AllocatorOption{
 		allocator.WithEvents(m.IdentityAllocator.GetEvents()), allocator.WithoutGC(), allocator.WithoutAutostart(),
 		allocator.WithCacheValidator(clusterIDValidator(remoteID)),
 		allocator.WithCacheValidator(clusterNameValidator(remoteName)),
-	)
+	}
+	if m.remoteIdentityRelevance != nil {
+		allocOpts = append(allocOpts, allocator.WithCacheValidator(relevanceValidator(m.remoteIdentityRelevance)))
+	}
+
+	remoteAlloc, err := allocator.NewAllocator(&key.GlobalIdentity{}, remoteAllocatorBackend, allocOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize remote Identity Allocator: %w", err)
 	}
@@ -858,3 +881,29 @@ func clusterNameValidator(clusterName string) allocator.CacheValidator {
 		return nil
 	}
 }
+
+// relevanceValidator returns a validator filtering out the identities that
+// are not relevant for the local cluster (i.e., not referenced by local
+// policy selectors), to reduce memory usage in meshes with a large number of
+// remote identities. The filtered identities are fetched on demand from the
+// remote kvstores upon lookup.
+func relevanceValidator(relevance IdentityRelevanceFunc) allocator.CacheValidator {
+	return func(kind allocator.AllocatorChangeKind, id idpool.ID, ak allocator.AllocatorKey) error {
+		if kind != allocator.AllocatorChangeUpsert {
+			// Don't filter out deletion events, to prevent leaving stale
+			// identities behind.
+			return nil
+		}
+
+		gi, ok := ak.(*key.GlobalIdentity)
+		if !ok {
+			return fmt.Errorf("unsupported key type %T", ak)
+		}
+
+		if !relevance(identity.NumericIdentity(id), gi.LabelArray) {
+			return fmt.Errorf("identity not referenced by local policy selectors: %w", allocator.ErrKeyFiltered)
+		}
+
+		return nil
+	}
+}
@@ -259,7 +259,15 @@ func (m *CachingIdentityAllocator) LookupIdentityByID(ctx context.Context, id id
 		return nil
 	}
 
-	allocatorKey, err := m.IdentityAllocator.GetByIDIncludeRemoteCaches(ctx, idpool.ID(id))
+	getByID := m.IdentityAllocator.GetByIDIncludeRemoteCaches
+	if m.remoteIdentityRelevance != nil {
+		// When relevance filtering is enabled, remote identities are only
+		// partially cached locally, and the missing ones need to be fetched
+		// on demand from the remote kvstores.
+		getByID = m.IdentityAllocator.GetByIDIncludeRemoteBackends
+	}
+
+	allocatorKey, err := getByID(ctx, idpool.ID(id))
 	if err != nil {
 		return nil
 	}
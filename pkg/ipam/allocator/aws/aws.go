@@ -6,9 +6,11 @@ package aws
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/prometheus/client_golang/prometheus"
 
 	operatorMetrics "github.com/cilium/cilium/operator/metrics"
 	operatorOption "github.com/cilium/cilium/operator/option"
@@ -76,6 +78,10 @@ func (a *AllocatorAWS) Init(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if roleARN := operatorOption.Config.AWSAssumeRoleARN; roleARN != "" {
+		log.WithField("roleARN", roleARN).Info("Assuming IAM role for EC2 operations")
+		cfg = ec2shim.AssumeRole(cfg, roleARN)
+	}
 	subnetsFilters := ec2shim.NewSubnetsFilters(operatorOption.Config.IPAMSubnetsTags, operatorOption.Config.IPAMSubnetsIDs)
 	instancesFilters := ec2shim.NewTagsFilter(operatorOption.Config.IPAMInstanceTags)
 
@@ -86,6 +92,10 @@ func (a *AllocatorAWS) Init(ctx context.Context) error {
 	}
 
 	eniCreationTags := operatorOption.Config.ENITags
+	if tmpl := operatorOption.Config.ENINameTemplate; tmpl != "" {
+		name := strings.ReplaceAll(tmpl, "{cluster-name}", option.Config.ClusterName)
+		eniCreationTags = ec2shim.MergeTags(eniCreationTags, map[string]string{"Name": name})
+	}
 	if operatorOption.Config.ENIGarbageCollectionInterval > 0 {
 		a.eniGCTags = initENIGarbageCollectionTags(ctx, cfg)
 		// Make sure GC tags are also used for ENI creation
@@ -93,15 +103,16 @@ func (a *AllocatorAWS) Init(ctx context.Context) error {
 	}
 
 	a.client = ec2shim.NewClient(ec2.NewFromConfig(cfg), aMetrics, operatorOption.Config.IPAMAPIQPSLimit,
-		operatorOption.Config.IPAMAPIBurst, subnetsFilters, instancesFilters, eniCreationTags,
-		operatorOption.Config.AWSUsePrimaryAddress)
+		operatorOption.Config.IPAMAPIBurst, operatorOption.Config.EC2APICacheTTL, subnetsFilters,
+		instancesFilters, eniCreationTags, operatorOption.Config.AWSUsePrimaryAddress)
 
 	if err := limits.UpdateFromUserDefinedMappings(operatorOption.Config.AWSInstanceLimitMapping); err != nil {
 		return fmt.Errorf("failed to parse aws-instance-limit-mapping: %w", err)
 	}
 	if operatorOption.Config.UpdateEC2AdapterLimitViaAPI {
 		if err := limits.UpdateFromEC2API(ctx, a.client); err != nil {
-			return fmt.Errorf("unable to update instance type to adapter limits from EC2 API: %w", err)
+			log.WithError(err).Warning(
+				"Unable to update instance type to adapter limits from EC2 API, falling back to the static table")
 		}
 	}
 	return nil
@@ -132,11 +143,28 @@ func (a *AllocatorAWS) Start(ctx context.Context, getterUpdater ipam.CiliumNodeG
 		return nil, err
 	}
 
+	if operatorOption.Config.UpdateEC2AdapterLimitViaAPI {
+		eni.StartENILimitsUpdater(ctx, a.client, defaults.ENILimitsUpdateInterval)
+	}
+
 	if operatorOption.Config.ENIGarbageCollectionInterval > 0 {
+		var reclaimedENIs prometheus.Counter
+		if operatorOption.Config.EnableMetrics {
+			reclaimedENIs = prometheus.NewCounter(prometheus.CounterOpts{
+				Namespace: metrics.Namespace,
+				Subsystem: "ipam",
+				Name:      "eni_gc_reclaimed_total",
+				Help:      "Number of leaked ENIs reclaimed by the garbage collector",
+			})
+			operatorMetrics.Registry.MustRegister(reclaimedENIs)
+		}
+
 		eni.StartENIGarbageCollector(ctx, a.client, eni.GarbageCollectionParams{
 			RunInterval:    operatorOption.Config.ENIGarbageCollectionInterval,
 			MaxPerInterval: defaults.ENIGarbageCollectionMaxPerInterval,
 			ENITags:        a.eniGCTags,
+			ReferencedENIs: instances.AttachedENIs,
+			ReclaimedENIs:  reclaimedENIs,
 		})
 	}
 
@@ -34,6 +34,8 @@ type prometheusMetrics struct {
 	AvailableIPsPerSubnet *prometheus.GaugeVec
 	Nodes                 *prometheus.GaugeVec
 	Resync                prometheus.Counter
+	ResyncDuration        prometheus.Histogram
+	ReleaseChurn          prometheus.Counter
 	poolMaintainer        *triggerMetrics
 	k8sSync               *triggerMetrics
 	resync                *triggerMetrics
@@ -139,6 +141,20 @@ func NewPrometheusMetrics(namespace string, registry metrics.RegisterGatherer) *
 		Help:      "Number of resync operations to synchronize and resolve IP deficit of nodes",
 	})
 
+	m.ResyncDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: ipamSubsystem,
+		Name:      "resync_duration_seconds",
+		Help:      "Duration of a full resync of all nodes",
+	})
+
+	m.ReleaseChurn = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: ipamSubsystem,
+		Name:      "release_churn_total",
+		Help:      "Number of IPs which were un-marked for release before the release completed",
+	})
+
 	m.Allocation = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Namespace: namespace,
 		Subsystem: ipamSubsystem,
@@ -181,6 +197,8 @@ func NewPrometheusMetrics(namespace string, registry metrics.RegisterGatherer) *
 	registry.MustRegister(m.AvailableIPsPerSubnet)
 	registry.MustRegister(m.Nodes)
 	registry.MustRegister(m.Resync)
+	registry.MustRegister(m.ResyncDuration)
+	registry.MustRegister(m.ReleaseChurn)
 	registry.MustRegister(m.Allocation)
 	registry.MustRegister(m.Release)
 	m.poolMaintainer.Register(registry)
@@ -214,6 +232,10 @@ func (p *prometheusMetrics) AddIPRelease(subnetID string, released int64) {
 	p.ReleaseIpOps.WithLabelValues(subnetID).Add(float64(released))
 }
 
+func (p *prometheusMetrics) AddIPReleaseChurn(churned int64) {
+	p.ReleaseChurn.Add(float64(churned))
+}
+
 func (p *prometheusMetrics) SetAllocatedIPs(typ string, allocated int) {
 	p.IPsAllocated.WithLabelValues(typ).Set(float64(allocated))
 }
@@ -242,6 +264,10 @@ func (p *prometheusMetrics) IncResyncCount() {
 	p.Resync.Inc()
 }
 
+func (p *prometheusMetrics) ObserveResyncDuration(duration float64) {
+	p.ResyncDuration.Observe(duration)
+}
+
 func (p *prometheusMetrics) AllocationAttempt(typ, status, subnetID string, observe float64) {
 	p.Allocation.WithLabelValues(typ, status, subnetID).Observe(observe)
 }
@@ -344,6 +370,7 @@ func (m *NoOpMetrics) ReleaseAttempt(typ, status, subnetID string, observe float
 func (m *NoOpMetrics) IncInterfaceAllocation(subnetID string)                                    {}
 func (m *NoOpMetrics) AddIPAllocation(subnetID string, allocated int64)                          {}
 func (m *NoOpMetrics) AddIPRelease(subnetID string, released int64)                              {}
+func (m *NoOpMetrics) AddIPReleaseChurn(churned int64)                                           {}
 func (m *NoOpMetrics) SetAllocatedIPs(typ string, allocated int)                                 {}
 func (m *NoOpMetrics) SetAvailableInterfaces(available int)                                      {}
 func (m *NoOpMetrics) SetInterfaceCandidates(interfaceCandidates int)                            {}
@@ -351,6 +378,7 @@ func (m *NoOpMetrics) SetEmptyInterfaceSlots(emptyInterfaceSlots int)
 func (m *NoOpMetrics) SetAvailableIPsPerSubnet(subnetID, availabilityZone string, available int) {}
 func (m *NoOpMetrics) SetNodes(category string, nodes int)                                       {}
 func (m *NoOpMetrics) IncResyncCount()                                                           {}
+func (m *NoOpMetrics) ObserveResyncDuration(duration float64)                                    {}
 func (m *NoOpMetrics) SetIPAvailable(node string, n int)                                         {}
 func (m *NoOpMetrics) SetIPUsed(node string, n int)                                              {}
 func (m *NoOpMetrics) SetIPNeeded(node string, n int)                                            {}
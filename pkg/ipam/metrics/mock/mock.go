@@ -16,6 +16,7 @@ type mockMetrics struct {
 	releaseAttempts       map[string]histogram
 	ipAllocations         map[string]int64
 	ipReleases            map[string]int64
+	ipReleaseChurn        int64
 	interfaceAllocations  map[string]int64
 	allocatedIPs          map[string]int
 	availableInterfaces   int
@@ -24,6 +25,7 @@ type mockMetrics struct {
 	availableIPsPerSubnet map[string]int
 	nodes                 map[string]int
 	resyncCount           int64
+	resyncDuration        histogram
 	nodeIPAvailable       map[string]int
 	nodeIPUsed            map[string]int
 	nodeIPNeeded          map[string]int
@@ -101,6 +103,18 @@ func (m *mockMetrics) AddIPRelease(subnetID string, released int64) {
 	m.mutex.Unlock()
 }
 
+func (m *mockMetrics) IPReleaseChurn() int64 {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.ipReleaseChurn
+}
+
+func (m *mockMetrics) AddIPReleaseChurn(churned int64) {
+	m.mutex.Lock()
+	m.ipReleaseChurn += churned
+	m.mutex.Unlock()
+}
+
 func (m *mockMetrics) AllocatedIPs(typ string) int {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
@@ -179,6 +193,19 @@ func (m *mockMetrics) IncResyncCount() {
 	m.mutex.Unlock()
 }
 
+func (m *mockMetrics) ResyncDurationCount() int64 {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.resyncDuration.count
+}
+
+func (m *mockMetrics) ObserveResyncDuration(duration float64) {
+	m.mutex.Lock()
+	m.resyncDuration.count++
+	m.resyncDuration.sum += duration
+	m.mutex.Unlock()
+}
+
 func (m *mockMetrics) SetIPAvailable(s string, n int) {
 	m.mutex.Lock()
 	m.nodeIPAvailable[s] = n
@@ -8,12 +8,14 @@ package ipam
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/client-go/tools/cache"
 
 	operatorOption "github.com/cilium/cilium/operator/option"
 	"github.com/cilium/cilium/operator/watchers"
+	"github.com/cilium/cilium/pkg/annotation"
 	"github.com/cilium/cilium/pkg/defaults"
 	"github.com/cilium/cilium/pkg/ipam/metrics"
 	ipamOption "github.com/cilium/cilium/pkg/ipam/option"
@@ -249,28 +251,55 @@ func (n *Node) loggerLocked() (logger *logrus.Entry) {
 	return
 }
 
+// getAnnotationOverride returns the value of the given CiliumNode annotation
+// as an integer. It returns dflt if the annotation is not set, and logs and
+// ignores annotations which are not non-negative integers.
+//
+// n.mutex must be held when calling this function
+func (n *Node) getAnnotationOverride(key string, dflt int) int {
+	value, ok := n.resource.Annotations[key]
+	if !ok {
+		return dflt
+	}
+	override, err := strconv.Atoi(value)
+	if err != nil || override < 0 {
+		n.loggerLocked().Warningf("Ignoring invalid %s annotation %q", key, value)
+		return dflt
+	}
+	return override
+}
+
 // getMaxAboveWatermark returns the max-above-watermark setting for an AWS node
 //
 // n.mutex must be held when calling this function
 func (n *Node) getMaxAboveWatermark() int {
-	return n.resource.Spec.IPAM.MaxAboveWatermark
+	return n.getAnnotationOverride(annotation.IPAMMaxAboveWatermarkKey, n.resource.Spec.IPAM.MaxAboveWatermark)
 }
 
 // getPreAllocate returns the pre-allocation setting for an AWS node
 //
 // n.mutex must be held when calling this function
 func (n *Node) getPreAllocate() int {
-	if n.resource.Spec.IPAM.PreAllocate != 0 {
-		return n.resource.Spec.IPAM.PreAllocate
+	preAllocate := n.resource.Spec.IPAM.PreAllocate
+	if preAllocate == 0 {
+		preAllocate = defaults.IPAMPreAllocation
 	}
-	return defaults.IPAMPreAllocation
+	return n.getAnnotationOverride(annotation.IPAMPreAllocateKey, preAllocate)
 }
 
 // getMinAllocate returns the minimum-allocation setting of an AWS node
 //
 // n.mutex must be held when calling this function
 func (n *Node) getMinAllocate() int {
-	return n.resource.Spec.IPAM.MinAllocate
+	return n.getAnnotationOverride(annotation.IPAMMinAllocateKey, n.resource.Spec.IPAM.MinAllocate)
+}
+
+// isInterrupted returns true if the instance backing the node received an
+// interruption notice and is about to be terminated
+//
+// n.mutex must be held when calling this function
+func (n *Node) isInterrupted() bool {
+	return n.resource.Annotations[annotation.IPAMInterruptedKey] == "true"
 }
 
 // getMaxAllocate returns the maximum-allocation setting of an AWS node
@@ -476,6 +505,13 @@ func (n *Node) recalculate() {
 	n.stats.IPv4.AvailableIPs = len(n.ipv4Alloc.available)
 	n.stats.IPv4.NeededIPs = calculateNeededIPs(n.stats.IPv4.AvailableIPs, n.stats.IPv4.UsedIPs, n.getPreAllocate(), n.getMinAllocate(), n.getMaxAllocate())
 	n.stats.IPv4.ExcessIPs = calculateExcessIPs(n.stats.IPv4.AvailableIPs, usedIPForExcessCalc, n.getPreAllocate(), n.getMinAllocate(), n.getMaxAboveWatermark())
+	if n.isInterrupted() {
+		// The instance is about to be terminated. Stop allocating new IPs
+		// and consider all unused IPs excess so they become available to
+		// the nodes the pods are rescheduled to.
+		n.stats.IPv4.NeededIPs = 0
+		n.stats.IPv4.ExcessIPs = math.IntMax(n.stats.IPv4.AvailableIPs-usedIPForExcessCalc, 0)
+	}
 	n.stats.IPv4.RemainingInterfaces = stats.RemainingAvailableInterfaceCount
 	n.stats.IPv4.Capacity = stats.NodeCapacity
 
@@ -801,8 +837,18 @@ func (n *Node) handleIPRelease(ctx context.Context, a *maintenanceAction) (insta
 		}
 	}
 
+	// churnedIPs counts the IPs which were un-marked for release because they
+	// are no longer considered excess. A high churn indicates that the
+	// warm pool targets are too tight for the allocation pattern of the node.
+	churnedIPs := 0
+
+	n.mutex.RLock()
+	interrupted := n.isInterrupted()
+	n.mutex.RUnlock()
+
 	if n.ipv4Alloc.ipsMarkedForRelease == nil || a.release == nil || len(a.release.IPsToRelease) == 0 {
 		// Resetting ipsMarkedForRelease if there are no IPs to release in this iteration
+		churnedIPs = len(n.ipv4Alloc.ipsMarkedForRelease)
 		n.ipv4Alloc.ipsMarkedForRelease = make(map[string]time.Time)
 	}
 
@@ -821,10 +867,12 @@ func (n *Node) handleIPRelease(ctx context.Context, a *maintenanceAction) (insta
 			// from local maps.
 			delete(n.ipv4Alloc.ipsMarkedForRelease, markedIP)
 			n.deleteLocalReleaseStatus(markedIP)
+			churnedIPs++
 			continue
 		}
-		// Check if the IP release waiting period elapsed
-		if ts.Add(time.Duration(operatorOption.Config.ExcessIPReleaseDelay) * time.Second).After(time.Now()) {
+		// Check if the IP release waiting period elapsed. The waiting period
+		// is skipped if the instance is about to be terminated.
+		if !interrupted && ts.Add(time.Duration(operatorOption.Config.ExcessIPReleaseDelay)*time.Second).After(time.Now()) {
 			continue
 		}
 		// Handling for IPs we've already heard back from agent.
@@ -852,6 +900,17 @@ func (n *Node) handleIPRelease(ctx context.Context, a *maintenanceAction) (insta
 	}
 	n.abortNoLongerExcessIPs(excessMap)
 
+	if churnedIPs > 0 {
+		n.manager.metricsAPI.AddIPReleaseChurn(int64(churnedIPs))
+	}
+
+	// Limit the number of IPs released per maintenance interval to dampen
+	// release bursts during scale-down. The remaining IPs stay marked and are
+	// released in subsequent intervals if they remain excess.
+	if batch := operatorOption.Config.ExcessIPReleaseBatchSize; batch > 0 && len(ipsToRelease) > batch {
+		ipsToRelease = ipsToRelease[:batch]
+	}
+
 	if len(ipsToRelease) > 0 {
 		a.release.IPsToRelease = ipsToRelease
 		scopedLog = scopedLog.WithFields(logrus.Fields{
@@ -141,6 +141,7 @@ type MetricsAPI interface {
 	IncInterfaceAllocation(subnetID string)
 	AddIPAllocation(subnetID string, allocated int64)
 	AddIPRelease(subnetID string, released int64)
+	AddIPReleaseChurn(churned int64)
 	SetAllocatedIPs(typ string, allocated int)
 	SetAvailableInterfaces(available int)
 	SetInterfaceCandidates(interfaceCandidates int)
@@ -148,6 +149,7 @@ type MetricsAPI interface {
 	SetAvailableIPsPerSubnet(subnetID string, availabilityZone string, available int)
 	SetNodes(category string, nodes int)
 	IncResyncCount()
+	ObserveResyncDuration(duration float64)
 	PoolMaintainerTrigger() trigger.MetricsObserver
 	K8sSyncTrigger() trigger.MetricsObserver
 	ResyncTrigger() trigger.MetricsObserver
@@ -450,6 +452,22 @@ func (n *NodeManager) GetNodesByIPWatermarkLocked() []*Node {
 	return list
 }
 
+// interleaveNodes reorders the given list by alternately taking a node from
+// the front and the back of the list. With the list ordered by needed
+// addresses, this interleaves nodes in deficit with healthy nodes so that
+// healthy nodes are not starved behind a long queue of nodes requiring
+// allocations.
+func interleaveNodes(list []*Node) []*Node {
+	interleaved := make([]*Node, 0, len(list))
+	for front, back := 0, len(list)-1; front <= back; front, back = front+1, back-1 {
+		interleaved = append(interleaved, list[front])
+		if front != back {
+			interleaved = append(interleaved, list[back])
+		}
+	}
+	return interleaved
+}
+
 type resyncStats struct {
 	mutex               lock.Mutex
 	ipv4                ipResyncStats
@@ -515,17 +533,18 @@ func (n *NodeManager) resyncNode(ctx context.Context, node *Node, stats *resyncS
 
 // Resync will attend all nodes and resolves IP deficits. The order of
 // attendance is defined by the number of IPs needed to reach the configured
-// watermarks. Any updates to the node resource are synchronized to the
-// Kubernetes apiserver.
+// watermarks, interleaved with healthy nodes for fairness. Any updates to the
+// node resource are synchronized to the Kubernetes apiserver.
 func (n *NodeManager) Resync(ctx context.Context, syncTime time.Time) {
 	n.mutex.Lock()
 	defer n.mutex.Unlock()
 	n.metricsAPI.IncResyncCount()
 
+	resyncStart := time.Now()
 	stats := resyncStats{}
 	sem := semaphore.NewWeighted(n.parallelWorkers)
 
-	for _, node := range n.GetNodesByIPWatermarkLocked() {
+	for _, node := range interleaveNodes(n.GetNodesByIPWatermarkLocked()) {
 		err := sem.Acquire(ctx, 1)
 		if err != nil {
 			continue
@@ -539,6 +558,7 @@ func (n *NodeManager) Resync(ctx context.Context, syncTime time.Time) {
 	// Acquire the full semaphore, this requires all goroutines to
 	// complete and thus blocks until all nodes are synced
 	sem.Acquire(ctx, n.parallelWorkers)
+	n.metricsAPI.ObserveResyncDuration(time.Since(resyncStart).Seconds())
 
 	n.metricsAPI.SetAllocatedIPs("used", stats.ipv4.totalUsed)
 	n.metricsAPI.SetAllocatedIPs("available", stats.ipv4.totalAvailable)
@@ -204,6 +204,26 @@ func TestGetNodeNames(t *testing.T) {
 	require.Equal(t, "node2", names[0])
 }
 
+func TestInterleaveNodes(t *testing.T) {
+	nodes := []*Node{
+		{name: "deficit-2"},
+		{name: "deficit-1"},
+		{name: "healthy-1"},
+		{name: "healthy-2"},
+		{name: "healthy-3"},
+	}
+
+	interleaved := interleaveNodes(nodes)
+	require.Len(t, interleaved, len(nodes))
+	names := make([]string, 0, len(interleaved))
+	for _, node := range interleaved {
+		names = append(names, node.name)
+	}
+	require.Equal(t, []string{"deficit-2", "healthy-3", "deficit-1", "healthy-2", "healthy-1"}, names)
+
+	require.Empty(t, interleaveNodes(nil))
+}
+
 func TestNodeManagerGet(t *testing.T) {
 	am := newAllocationImplementationMock()
 	require.NotNil(t, am)
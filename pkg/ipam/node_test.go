@@ -7,6 +7,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/annotation"
+	"github.com/cilium/cilium/pkg/defaults"
+	v2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
 )
 
 type testNeededDef struct {
@@ -66,3 +70,49 @@ func TestCalculateExcessIPs(t *testing.T) {
 		require.Equal(t, d.result, result)
 	}
 }
+
+func TestWarmPoolAnnotationOverrides(t *testing.T) {
+	cn := &v2.CiliumNode{}
+	cn.Spec.IPAM.PreAllocate = 8
+	cn.Spec.IPAM.MinAllocate = 4
+	cn.Spec.IPAM.MaxAboveWatermark = 2
+	n := &Node{resource: cn}
+
+	// Without annotations, the spec values apply
+	require.Equal(t, 8, n.getPreAllocate())
+	require.Equal(t, 4, n.getMinAllocate())
+	require.Equal(t, 2, n.getMaxAboveWatermark())
+
+	cn.Annotations = map[string]string{
+		annotation.IPAMPreAllocateKey:       "32",
+		annotation.IPAMMinAllocateKey:       "16",
+		annotation.IPAMMaxAboveWatermarkKey: "8",
+	}
+	require.Equal(t, 32, n.getPreAllocate())
+	require.Equal(t, 16, n.getMinAllocate())
+	require.Equal(t, 8, n.getMaxAboveWatermark())
+
+	// Invalid annotations fall back to the spec values
+	cn.Annotations[annotation.IPAMPreAllocateKey] = "-1"
+	cn.Annotations[annotation.IPAMMinAllocateKey] = "many"
+	require.Equal(t, 8, n.getPreAllocate())
+	require.Equal(t, 4, n.getMinAllocate())
+
+	// The pre-allocate default applies if neither spec nor annotation are set
+	cn.Spec.IPAM.PreAllocate = 0
+	delete(cn.Annotations, annotation.IPAMPreAllocateKey)
+	require.Equal(t, defaults.IPAMPreAllocation, n.getPreAllocate())
+}
+
+func TestInterruptedAnnotation(t *testing.T) {
+	cn := &v2.CiliumNode{}
+	n := &Node{resource: cn}
+
+	require.False(t, n.isInterrupted())
+
+	cn.Annotations = map[string]string{annotation.IPAMInterruptedKey: "false"}
+	require.False(t, n.isInterrupted())
+
+	cn.Annotations[annotation.IPAMInterruptedKey] = "true"
+	require.True(t, n.isInterrupted())
+}
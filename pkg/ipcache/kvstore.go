@@ -22,6 +22,7 @@ import (
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/source"
+	"github.com/cilium/cilium/pkg/time"
 	"github.com/cilium/cilium/pkg/types"
 	"github.com/cilium/cilium/pkg/u8proto"
 )
@@ -274,6 +275,12 @@ func (iw *IPIdentityWatcher) Synced() bool {
 	return iw.store.Synced()
 }
 
+// SyncedAt returns the time at which the initial list of entries was last
+// retrieved from the kvstore, or the zero time if it never completed.
+func (iw *IPIdentityWatcher) SyncedAt() time.Time {
+	return iw.store.SyncedAt()
+}
+
 // OnUpdate is triggered when a new upsertion event is observed, and
 // synchronizes local caching of endpoint IP to ipIDPair mapping with
 // the operation the key-value store has informed us about.
@@ -91,6 +91,13 @@ type CiliumLoadBalancerIPPoolSpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default=false
 	Disabled bool `json:"disabled"`
+	// MaxIPsPerNamespace limits the amount of IPs from this pool which can be
+	// allocated to services within a single namespace. If unset or zero, no
+	// limit is enforced.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MaxIPsPerNamespace *int64 `json:"maxIPsPerNamespace,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=Yes;No
@@ -1585,6 +1585,11 @@ func (in *CiliumLoadBalancerIPPoolSpec) DeepCopyInto(out *CiliumLoadBalancerIPPo
 		*out = make([]CiliumLoadBalancerIPPoolIPBlock, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaxIPsPerNamespace != nil {
+		in, out := &in.MaxIPsPerNamespace, &out.MaxIPsPerNamespace
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
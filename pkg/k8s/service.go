@@ -55,6 +55,14 @@ func getAnnotationShared(svc *slim_corev1.Service) bool {
 	return true
 }
 
+func getAnnotationPassiveHealth(svc *slim_corev1.Service) bool {
+	if value, ok := annotation.Get(svc, annotation.ServicePassiveHealth); ok {
+		return strings.ToLower(value) == "true"
+	}
+
+	return false
+}
+
 func getAnnotationServiceAffinity(svc *slim_corev1.Service) string {
 	// The ServiceAffinity annotation is ignored if the service is not declared as global.
 	if !getAnnotationIncludeExternal(svc) {
@@ -68,6 +76,28 @@ func getAnnotationServiceAffinity(svc *slim_corev1.Service) string {
 	return serviceAffinityNone
 }
 
+func getAnnotationServiceClusterPreference(svc *slim_corev1.Service) []string {
+	// The ServiceClusterPreference annotation is ignored if the service is
+	// not declared as global.
+	if !getAnnotationIncludeExternal(svc) {
+		return nil
+	}
+
+	value, ok := annotation.Get(svc, annotation.ServiceClusterPreference)
+	if !ok {
+		return nil
+	}
+
+	var clusters []string
+	for _, cluster := range strings.Split(value, ",") {
+		if cluster = strings.TrimSpace(cluster); cluster != "" {
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	return clusters
+}
+
 func getTopologyAware(svc *slim_corev1.Service) bool {
 	return getAnnotationTopologyAwareHints(svc) ||
 		(svc.Spec.TrafficDistribution != nil &&
@@ -191,6 +221,8 @@ func ParseService(svc *slim_corev1.Service, nodePortAddrs []netip.Addr) (Service
 	svcInfo.IncludeExternal = getAnnotationIncludeExternal(svc)
 	svcInfo.Shared = getAnnotationShared(svc)
 	svcInfo.ServiceAffinity = getAnnotationServiceAffinity(svc)
+	svcInfo.ClusterPreference = getAnnotationServiceClusterPreference(svc)
+	svcInfo.PassiveHealth = getAnnotationPassiveHealth(svc)
 
 	if svc.Spec.SessionAffinity == slim_corev1.ServiceAffinityClientIP {
 		svcInfo.SessionAffinity = true
@@ -336,6 +368,12 @@ type Service struct {
 	// Applicable values: local, remote, none (default).
 	ServiceAffinity string
 
+	// ClusterPreference lists, in decreasing priority order, the clusters
+	// whose backends should be preferred for a global service. Backends of
+	// a lower-priority cluster are only programmed when all higher-priority
+	// clusters have no healthy backends, with automatic fail-back.
+	ClusterPreference []string
+
 	// ExtTrafficPolicy controls how backends are selected for North-South traffic.
 	// If set to "Local", only node-local backends are chosen.
 	ExtTrafficPolicy loadbalancer.SVCTrafficPolicy
@@ -379,6 +417,10 @@ type Service struct {
 	// SessionAffinityTimeoutSeconds denotes session affinity timeout
 	SessionAffinityTimeoutSec uint32
 
+	// PassiveHealth denotes whether passive backend health scoring is
+	// enabled for the service
+	PassiveHealth bool
+
 	// Type is the internal service type
 	// +deepequal-gen=false
 	Type loadbalancer.SVCType
@@ -582,6 +624,17 @@ func NewClusterService(id ServiceID, k8sService *Service, k8sEndpoints *Endpoint
 		if backend.Hostname != "" {
 			svc.Hostnames[addrCluster.Addr().String()] = backend.Hostname
 		}
+
+		if backend.Zone != "" || len(backend.HintsForZones) > 0 || backend.Terminating {
+			if svc.BackendHints == nil {
+				svc.BackendHints = map[string]*serviceStore.BackendHint{}
+			}
+			svc.BackendHints[addrCluster.Addr().String()] = &serviceStore.BackendHint{
+				Zone:        backend.Zone,
+				ForZones:    backend.HintsForZones,
+				Terminating: backend.Terminating,
+			}
+		}
 	}
 
 	svc.Shared = k8sService.Shared
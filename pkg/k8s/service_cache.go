@@ -629,6 +629,7 @@ func (s *ServiceCache) filterEndpoints(localEndpoints *Endpoints, svc *Service)
 // OR Remote endpoints exist which correlate to the service.
 func (s *ServiceCache) correlateEndpoints(id ServiceID) (*Endpoints, bool) {
 	endpoints := newEndpoints()
+	backendCluster := map[cmtypes.AddrCluster]string{}
 
 	localEndpoints := s.endpoints[id].GetEndpoints()
 	svc, svcFound := s.services[id]
@@ -640,6 +641,7 @@ func (s *ServiceCache) correlateEndpoints(id ServiceID) (*Endpoints, bool) {
 		for ip, e := range localEndpoints.Backends {
 			e.Preferred = svcFound && svc.IncludeExternal && svc.ServiceAffinity == serviceAffinityLocal
 			endpoints.Backends[ip] = e.DeepCopy()
+			backendCluster[ip] = option.Config.ClusterName
 		}
 	}
 
@@ -663,17 +665,47 @@ func (s *ServiceCache) correlateEndpoints(id ServiceID) (*Endpoints, bool) {
 					} else {
 						e.Preferred = svc.ServiceAffinity == serviceAffinityRemote
 						endpoints.Backends[ip] = e.DeepCopy()
+						backendCluster[ip] = clusterName
 					}
 				}
 			}
 		}
 	}
 
+	if svcFound && len(svc.ClusterPreference) > 0 {
+		filterByClusterPreference(endpoints, backendCluster, svc.ClusterPreference)
+	}
+
 	// Report the service as ready if a local endpoints object exists or if
 	// external endpoints have been identified
 	return endpoints, hasLocalEndpoints || hasExternalEndpoints
 }
 
+// filterByClusterPreference restricts the correlated backends to those of the
+// highest priority cluster having at least one healthy (i.e., not terminating)
+// backend, among the ones listed in the preference order annotated on the
+// service. All backends are retained as a fallback when no preferred cluster
+// has healthy backends. Fail-over and fail-back are automatic, as the
+// correlation is recomputed upon every service and endpoints change.
+func filterByClusterPreference(endpoints *Endpoints, backendCluster map[cmtypes.AddrCluster]string, preference []string) {
+	for _, cluster := range preference {
+		selected := map[cmtypes.AddrCluster]*Backend{}
+		healthy := false
+
+		for addr, backend := range endpoints.Backends {
+			if backendCluster[addr] == cluster {
+				selected[addr] = backend
+				healthy = healthy || !backend.Terminating
+			}
+		}
+
+		if healthy {
+			endpoints.Backends = selected
+			return
+		}
+	}
+}
+
 // mergeExternalServiceOption is the type for the options to customize the behavior of external services merging.
 type mergeExternalServiceOption int
 
@@ -730,7 +762,13 @@ func (s *ServiceCache) mergeServiceUpdateLocked(service *serviceStore.ClusterSer
 				continue
 			}
 
-			backends[addr] = &Backend{Ports: portConfig}
+			backend := &Backend{Ports: portConfig}
+			if hint, ok := service.BackendHints[ipString]; ok && hint != nil {
+				backend.Zone = hint.Zone
+				backend.HintsForZones = hint.ForZones
+				backend.Terminating = hint.Terminating
+			}
+			backends[addr] = backend
 		}
 		externalEndpoints.endpoints[service.Cluster] = &Endpoints{
 			Backends: backends,
@@ -971,6 +971,9 @@ func TestClusterServiceMerging(t *testing.T) {
 				"port": {Protocol: loadbalancer.TCP, Port: 80},
 			},
 		},
+		BackendHints: map[string]*serviceStore.BackendHint{
+			"3.3.3.3": {Zone: "zone-a", ForZones: []string{"zone-a"}},
+		},
 		IncludeExternal: false,
 		Shared:          false,
 	}, swgSvcs)
@@ -992,6 +995,8 @@ func TestClusterServiceMerging(t *testing.T) {
 			Ports: serviceStore.PortConfiguration{
 				"port": {Protocol: loadbalancer.TCP, Port: 80},
 			},
+			Zone:          "zone-a",
+			HintsForZones: []string{"zone-a"},
 		}, event.Endpoints.Backends[cmtypes.MustParseAddrCluster("3.3.3.3")])
 
 		return true
@@ -1127,6 +1127,48 @@ func TestNewClusterService(t *testing.T) {
 	}, clusterService)
 }
 
+func TestNewClusterServiceBackendHints(t *testing.T) {
+	id, svc := ParseService(
+		&slim_corev1.Service{
+			ObjectMeta: slim_metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: "bar",
+			},
+			Spec: slim_corev1.ServiceSpec{
+				ClusterIP: "127.0.0.1",
+				Type:      slim_corev1.ServiceTypeClusterIP,
+			},
+		}, nil)
+
+	ports := map[string]*loadbalancer.L4Addr{
+		"http-test-svc": {Protocol: loadbalancer.TCP, Port: 8080},
+	}
+	endpoints := &Endpoints{
+		Backends: map[cmtypes.AddrCluster]*Backend{
+			cmtypes.MustParseAddrCluster("10.0.0.2"): {
+				Ports: ports,
+				Zone:  "zone-a",
+				HintsForZones: []string{
+					"zone-a", "zone-b",
+				},
+			},
+			cmtypes.MustParseAddrCluster("10.0.0.3"): {
+				Ports:       ports,
+				Terminating: true,
+			},
+			cmtypes.MustParseAddrCluster("10.0.0.4"): {
+				Ports: ports,
+			},
+		},
+	}
+
+	clusterService := NewClusterService(id, svc, endpoints)
+	require.EqualValues(t, map[string]*serviceStore.BackendHint{
+		"10.0.0.2": {Zone: "zone-a", ForZones: []string{"zone-a", "zone-b"}},
+		"10.0.0.3": {Terminating: true},
+	}, clusterService.BackendHints)
+}
+
 func TestParseServiceIDFrom(t *testing.T) {
 	type args struct {
 		dn string
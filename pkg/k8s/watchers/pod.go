@@ -335,7 +335,8 @@ func (k *K8sWatcher) updateK8sPodV1(oldK8sPod, newK8sPod *slim_corev1.Pod) error
 	annoChangedProxy := !k8s.AnnotationsEqual([]string{annotation.ProxyVisibility, annotation.ProxyVisibilityAlias}, oldAnno, newAnno)
 	annoChangedBandwidth := !k8s.AnnotationsEqual([]string{bandwidth.EgressBandwidth}, oldAnno, newAnno)
 	annoChangedNoTrack := !k8s.AnnotationsEqual([]string{annotation.NoTrack, annotation.NoTrackAlias}, oldAnno, newAnno)
-	annotationsChanged := annoChangedProxy || annoChangedBandwidth || annoChangedNoTrack
+	annoChangedDSCP := !k8s.AnnotationsEqual([]string{annotation.DSCPEgress}, oldAnno, newAnno)
+	annotationsChanged := annoChangedProxy || annoChangedBandwidth || annoChangedNoTrack || annoChangedDSCP
 
 	// Check label updates too.
 	oldK8sPodLabels, _ := labelsfilter.Filter(labels.Map2Labels(oldK8sPod.ObjectMeta.Labels, labels.LabelSourceK8s))
@@ -434,17 +435,29 @@ func (k *K8sWatcher) updateK8sPodV1(oldK8sPod, newK8sPod *slim_corev1.Pod) error
 					return value, nil
 				})
 			}
-			realizePodAnnotationUpdate(podEP)
+			regenLevel := regeneration.RegenerateWithoutDatapath
+			if annoChangedDSCP {
+				podEP.UpdateDSCPPolicy(func(ns, podName string) (dscpEgress string, err error) {
+					p, err := k.GetCachedPod(ns, podName)
+					if err != nil {
+						return "", nil
+					}
+					return p.ObjectMeta.Annotations[annotation.DSCPEgress], nil
+				})
+				// The DSCP mark is compiled into the endpoint's datapath.
+				regenLevel = regeneration.RegenerateWithDatapath
+			}
+			realizePodAnnotationUpdate(podEP, regenLevel)
 		}
 	}
 
 	return err
 }
 
-func realizePodAnnotationUpdate(podEP *endpoint.Endpoint) {
+func realizePodAnnotationUpdate(podEP *endpoint.Endpoint, regenLevel regeneration.DatapathRegenerationLevel) {
 	regenMetadata := &regeneration.ExternalRegenerationMetadata{
 		Reason:            "annotations updated",
-		RegenerationLevel: regeneration.RegenerateWithoutDatapath,
+		RegenerationLevel: regenLevel,
 	}
 	// No need to log an error if the state transition didn't succeed,
 	// if it didn't succeed that means the endpoint is being deleted, or
@@ -348,6 +348,7 @@ func datapathSVCs(svc *k8s.Service, endpoints *k8s.Endpoints) (svcs []loadbalanc
 		svcs[i].HealthCheckNodePort = svc.HealthCheckNodePort
 		svcs[i].SessionAffinity = svc.SessionAffinity
 		svcs[i].SessionAffinityTimeoutSec = svc.SessionAffinityTimeoutSec
+		svcs[i].PassiveHealth = svc.PassiveHealth
 		if svcs[i].Type == loadbalancer.SVCTypeLoadBalancer {
 			svcs[i].LoadBalancerSourceRanges = lbSrcRanges
 		}
@@ -410,6 +411,7 @@ func (k *K8sWatcher) addK8sSVCs(svcID k8s.ServiceID, oldSvc, svc *k8s.Service, e
 			IntTrafficPolicy:          dpSvc.IntTrafficPolicy,
 			SessionAffinity:           dpSvc.SessionAffinity,
 			SessionAffinityTimeoutSec: dpSvc.SessionAffinityTimeoutSec,
+			PassiveHealth:             dpSvc.PassiveHealth,
 			HealthCheckNodePort:       dpSvc.HealthCheckNodePort,
 			LoadBalancerSourceRanges:  dpSvc.LoadBalancerSourceRanges,
 			Name: loadbalancer.ServiceName{
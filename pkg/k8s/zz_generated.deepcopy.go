@@ -64,6 +64,11 @@ func (in *Endpoints) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Service) DeepCopyInto(out *Service) {
 	*out = *in
+	if in.ClusterPreference != nil {
+		in, out := &in.ClusterPreference, &out.ClusterPreference
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.FrontendIPs != nil {
 		in, out := &in.FrontendIPs, &out.FrontendIPs
 		*out = make([]net.IP, len(*in))
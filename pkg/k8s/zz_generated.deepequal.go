@@ -175,6 +175,23 @@ func (in *Service) deepEqual(other *Service) bool {
 	if in.ServiceAffinity != other.ServiceAffinity {
 		return false
 	}
+	if ((in.ClusterPreference != nil) && (other.ClusterPreference != nil)) || ((in.ClusterPreference == nil) != (other.ClusterPreference == nil)) {
+		in, other := &in.ClusterPreference, &other.ClusterPreference
+		if other == nil {
+			return false
+		}
+
+		if len(*in) != len(*other) {
+			return false
+		} else {
+			for i, inElement := range *in {
+				if inElement != (*other)[i] {
+					return false
+				}
+			}
+		}
+	}
+
 	if in.ExtTrafficPolicy != other.ExtTrafficPolicy {
 		return false
 	}
@@ -295,6 +312,9 @@ func (in *Service) deepEqual(other *Service) bool {
 	if in.SessionAffinityTimeoutSec != other.SessionAffinityTimeoutSec {
 		return false
 	}
+	if in.PassiveHealth != other.PassiveHealth {
+		return false
+	}
 	if in.TopologyAware != other.TopologyAware {
 		return false
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/cilium/cilium/pkg/allocator"
 	"github.com/cilium/cilium/pkg/idpool"
 	"github.com/cilium/cilium/pkg/kvstore"
+	"github.com/cilium/cilium/pkg/kvstore/store"
 	"github.com/cilium/cilium/pkg/logging"
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/rate"
@@ -586,6 +587,18 @@ func (k *kvstoreBackend) ListAndWatch(ctx context.Context, handler allocator.Cac
 						continue
 					}
 
+					// Identities synced by a remote clustermesh-apiserver may
+					// be compressed when the compressed-sync capability has
+					// been negotiated.
+					s, err = store.DecompressValue(s)
+					if err != nil {
+						log.WithError(err).WithFields(logrus.Fields{
+							logfields.Key:   event.Key,
+							logfields.Value: event.Value,
+						}).Warning("Unable to decompress key value")
+						continue
+					}
+
 					key = k.keyType.PutKey(string(s))
 				} else {
 					if event.Typ != kvstore.EventTypeDelete {
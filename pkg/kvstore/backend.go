@@ -49,6 +49,17 @@ type ExtraOptions struct {
 
 	// NoEndpointStatusChecks disables the status checks for the endpoints
 	NoEndpointStatusChecks bool
+
+	// Endpoints, when set, overrides the etcd client endpoints retrieved
+	// from the configuration file. The order is preserved (i.e., the
+	// endpoints are not shuffled), so that the caller can implement its
+	// own endpoint selection policy.
+	Endpoints []string
+
+	// Namespace, when set, transparently prefixes all keys with the given
+	// string, so that multiple installations can safely share the same
+	// kvstore without key collisions.
+	Namespace string
 }
 
 // StatusCheckInterval returns the interval of status checks depending on the
@@ -129,6 +140,24 @@ func getBackend(name string) backendModule {
 	return nil
 }
 
+// TxnCondition compares the current state of a key against the expected one.
+// A nil Value asserts that the key does not exist, while a non-nil Value
+// asserts that the key exists with exactly that value.
+type TxnCondition struct {
+	Key   string
+	Value []byte
+}
+
+// TxnOp represents a single modification applied by a transaction. The key
+// is deleted when Delete is set, and created or updated with the given value
+// (optionally attaching a lease) otherwise.
+type TxnOp struct {
+	Key    string
+	Value  []byte
+	Lease  bool
+	Delete bool
+}
+
 // BackendOperations are the individual kvstore operations that each backend
 // must implement. Direct use of this interface is possible but will bypass the
 // tracing layer.
@@ -178,6 +207,12 @@ type BackendOperations interface {
 	// UpdateIfDifferentIfLocked updates a key if the value is different and if the client is still holding the given lock.
 	UpdateIfDifferentIfLocked(ctx context.Context, key string, value []byte, lease bool, lock KVLocker) (bool, error)
 
+	// Txn atomically applies the given operations if all the conditions
+	// hold, returning whether the transaction succeeded. It allows callers
+	// to perform compare-and-swap updates across multiple keys without
+	// read-modify-write races. (Only implemented for etcd)
+	Txn(ctx context.Context, conditions []TxnCondition, ops []TxnOp) (bool, error)
+
 	// CreateOnly atomically creates a key or fails if it already exists
 	CreateOnly(ctx context.Context, key string, value []byte, lease bool) (bool, error)
 
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"context"
+)
+
+// BackendModule is the interface an out-of-tree kvstore backend (e.g., a
+// CRD-backed or in-memory mesh store) has to implement to be registered
+// through RegisterBackend and selected by name, in the same way as the
+// built-in backends.
+type BackendModule interface {
+	// Name returns the name of the backend module.
+	Name() string
+
+	// NewInstance returns a fresh instance of the backend module.
+	NewInstance() BackendModule
+
+	// SetConfig validates and applies the specified configuration options.
+	// It is called once before NewClient().
+	SetConfig(opts map[string]string) error
+
+	// SetExtraConfig applies the options that cannot be expressed as
+	// strings. It is called once before NewClient().
+	SetExtraConfig(opts *ExtraOptions) error
+
+	// GetConfig returns the current backend configuration.
+	GetConfig() map[string]string
+
+	// NewClient initializes the backend and creates a new kvstore client
+	// implementing the BackendOperations interface. Errors occurring while
+	// establishing the connection are reported through the returned channel,
+	// which is closed when the connection attempt has been successful.
+	NewClient(ctx context.Context, opts *ExtraOptions) (BackendOperations, chan error)
+}
+
+// RegisterBackend registers an out-of-tree kvstore backend, so that it can
+// be subsequently selected by name (e.g., through the --kvstore flag, or when
+// connecting to remote clusters). It panics if a backend with the same name
+// has already been registered.
+func RegisterBackend(module BackendModule) {
+	registerBackend(module.Name(), &externalModule{module})
+}
+
+// externalModule adapts a BackendModule to the internal backendModule
+// interface implemented by the built-in backends.
+type externalModule struct {
+	module BackendModule
+}
+
+func (e *externalModule) getName() string {
+	return e.module.Name()
+}
+
+func (e *externalModule) setConfig(opts map[string]string) error {
+	return e.module.SetConfig(opts)
+}
+
+func (e *externalModule) setExtraConfig(opts *ExtraOptions) error {
+	return e.module.SetExtraConfig(opts)
+}
+
+func (e *externalModule) setConfigDummy() {}
+
+func (e *externalModule) getConfig() map[string]string {
+	return e.module.GetConfig()
+}
+
+func (e *externalModule) newClient(ctx context.Context, opts *ExtraOptions) (BackendOperations, chan error) {
+	return e.module.NewClient(ctx, opts)
+}
+
+func (e *externalModule) createInstance() backendModule {
+	return &externalModule{e.module.NewInstance()}
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBackendModule struct {
+	config map[string]string
+}
+
+func (f *fakeBackendModule) Name() string { return "fake-backend" }
+
+func (f *fakeBackendModule) NewInstance() BackendModule {
+	return &fakeBackendModule{}
+}
+
+func (f *fakeBackendModule) SetConfig(opts map[string]string) error {
+	f.config = opts
+	return nil
+}
+
+func (f *fakeBackendModule) SetExtraConfig(opts *ExtraOptions) error { return nil }
+
+func (f *fakeBackendModule) GetConfig() map[string]string { return f.config }
+
+func (f *fakeBackendModule) NewClient(ctx context.Context, opts *ExtraOptions) (BackendOperations, chan error) {
+	errChan := make(chan error)
+	close(errChan)
+	return newNamespaceFakeBackend(), errChan
+}
+
+func TestRegisterBackend(t *testing.T) {
+	RegisterBackend(&fakeBackendModule{})
+	t.Cleanup(func() { delete(registeredBackends, "fake-backend") })
+
+	// Registering a backend with the same name shall panic.
+	require.Panics(t, func() { RegisterBackend(&fakeBackendModule{}) })
+
+	backend, errChan := NewClient(context.Background(), "fake-backend",
+		map[string]string{"fake.opt": "value"}, nil)
+	require.NotNil(t, backend)
+	require.NoError(t, <-errChan)
+}
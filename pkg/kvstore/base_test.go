@@ -38,6 +38,29 @@ func testLock(t *testing.T) {
 	}
 }
 
+func TestLockFencingToken(t *testing.T) {
+	testutils.IntegrationTest(t)
+	SetupDummyWithConfigOpts(t, "etcd", opts("etcd"))
+
+	path := "locktest/fencing"
+
+	Client().DeletePrefix(context.TODO(), path)
+	defer Client().DeletePrefix(context.TODO(), path)
+
+	// The fencing token shall increase across subsequent acquisitions.
+	var previous FencingToken
+	for i := 0; i < 3; i++ {
+		lock, err := LockPath(context.Background(), Client(), path)
+		require.NoError(t, err)
+
+		token := lock.FencingToken()
+		require.Greater(t, token, previous)
+		previous = token
+
+		require.NoError(t, lock.Unlock(context.TODO()))
+	}
+}
+
 func testKey(prefix string, i int) string {
 	return fmt.Sprintf("%s%s/%010d", prefix, "foo", i)
 }
@@ -96,6 +119,55 @@ func testGetSet(t *testing.T) {
 	require.Len(t, pairs, 0)
 }
 
+func TestTxn(t *testing.T) {
+	testutils.IntegrationTest(t)
+	SetupDummyWithConfigOpts(t, "etcd", opts("etcd"))
+	testTxn(t)
+}
+
+func testTxn(t *testing.T) {
+	prefix := "txn-test/"
+
+	Client().DeletePrefix(context.TODO(), prefix)
+	defer Client().DeletePrefix(context.TODO(), prefix)
+
+	key1, key2 := testKey(prefix, 1), testKey(prefix, 2)
+
+	// The transaction shall succeed given that none of the keys exists.
+	success, err := Client().Txn(context.TODO(),
+		[]TxnCondition{{Key: key1}, {Key: key2}},
+		[]TxnOp{{Key: key1, Value: []byte("one")}, {Key: key2, Value: []byte("two")}})
+	require.NoError(t, err)
+	require.True(t, success)
+
+	val, err := Client().Get(context.TODO(), key2)
+	require.NoError(t, err)
+	require.EqualValues(t, "two", string(val))
+
+	// The transaction shall fail, and apply no modification, given that
+	// the value of one of the keys does not match the expected one.
+	success, err = Client().Txn(context.TODO(),
+		[]TxnCondition{{Key: key1, Value: []byte("one")}, {Key: key2, Value: []byte("stale")}},
+		[]TxnOp{{Key: key1, Value: []byte("three")}, {Key: key2, Delete: true}})
+	require.NoError(t, err)
+	require.False(t, success)
+
+	val, err = Client().Get(context.TODO(), key1)
+	require.NoError(t, err)
+	require.EqualValues(t, "one", string(val))
+
+	// The transaction shall succeed given that all the values match.
+	success, err = Client().Txn(context.TODO(),
+		[]TxnCondition{{Key: key1, Value: []byte("one")}, {Key: key2, Value: []byte("two")}},
+		[]TxnOp{{Key: key1, Value: []byte("three")}, {Key: key2, Delete: true}})
+	require.NoError(t, err)
+	require.True(t, success)
+
+	val, err = Client().Get(context.TODO(), key2)
+	require.NoError(t, err)
+	require.Nil(t, val)
+}
+
 func BenchmarkGet(b *testing.B) {
 	testutils.IntegrationTest(b)
 	for _, backendName := range []string{"etcd", "consul"} {
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"github.com/cilium/cilium/pkg/time"
+)
+
+// BatchedWatcher wraps a Watcher to deliver events in batches, coalescing
+// multiple events for the same key into the most recent one. This allows
+// consumers to process bursts of events (e.g., during the initial
+// synchronization or following a reconnection) with fewer store transactions.
+type BatchedWatcher struct {
+	// Events emits batches of coalesced events, at most one per interval.
+	// It is closed when the underlying watcher terminates, after emitting
+	// the possibly pending events.
+	Events <-chan []KeyValueEvent
+
+	watcher *Watcher
+}
+
+// NewBatchedWatcher wraps the given watcher to deliver events in batches.
+// Events received within the same interval are emitted together, and events
+// referring to the same key are coalesced into the most recent one, preserving
+// the relative order of the keys. EventTypeListDone events flush the pending
+// batch and are delivered immediately, so that consumers can reliably detect
+// the completion of the initial list operation.
+func NewBatchedWatcher(w *Watcher, interval time.Duration) *BatchedWatcher {
+	events := make(chan []KeyValueEvent)
+	bw := &BatchedWatcher{
+		Events:  events,
+		watcher: w,
+	}
+
+	go bw.run(events, interval)
+	return bw
+}
+
+// Stop stops the underlying watcher. The Events channel is closed after
+// emitting the possibly pending events, and shall be drained by the consumer.
+func (bw *BatchedWatcher) Stop() {
+	bw.watcher.Stop()
+}
+
+func (bw *BatchedWatcher) run(out chan<- []KeyValueEvent, interval time.Duration) {
+	defer close(out)
+
+	var (
+		batch   []KeyValueEvent
+		pending = make(map[string]int)
+	)
+
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+
+		out <- batch
+		batch, pending = nil, make(map[string]int)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event, ok := <-bw.watcher.Events:
+			if !ok {
+				flush()
+				return
+			}
+
+			if event.Typ == EventTypeListDone {
+				flush()
+				out <- []KeyValueEvent{event}
+				continue
+			}
+
+			if idx, ok := pending[event.Key]; ok {
+				batch[idx] = event
+			} else {
+				pending[event.Key] = len(batch)
+				batch = append(batch, event)
+			}
+
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/time"
+)
+
+func TestBatchedWatcher(t *testing.T) {
+	w := newWatcher("foo/", 16)
+	bw := NewBatchedWatcher(w, 50*time.Millisecond)
+
+	event := func(typ EventType, key, value string) KeyValueEvent {
+		return KeyValueEvent{Typ: typ, Key: key, Value: []byte(value)}
+	}
+
+	expect := func(expected []KeyValueEvent) {
+		t.Helper()
+		select {
+		case batch := <-bw.Events:
+			require.Equal(t, expected, batch)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout while waiting for batched watcher event")
+		}
+	}
+
+	// Events part of the initial list shall be flushed by the list done
+	// event, which is in turn delivered immediately in its own batch.
+	w.Events <- event(EventTypeCreate, "foo/key1", "val1")
+	w.Events <- event(EventTypeCreate, "foo/key2", "val2")
+	w.Events <- event(EventTypeListDone, "", "")
+
+	expect([]KeyValueEvent{
+		event(EventTypeCreate, "foo/key1", "val1"),
+		event(EventTypeCreate, "foo/key2", "val2"),
+	})
+	expect([]KeyValueEvent{event(EventTypeListDone, "", "")})
+
+	// Multiple events for the same key shall be coalesced into the most
+	// recent one, preserving the relative order of the keys.
+	w.Events <- event(EventTypeModify, "foo/key1", "val1a")
+	w.Events <- event(EventTypeCreate, "foo/key3", "val3")
+	w.Events <- event(EventTypeModify, "foo/key1", "val1b")
+	w.Events <- event(EventTypeDelete, "foo/key2", "")
+
+	expect([]KeyValueEvent{
+		event(EventTypeModify, "foo/key1", "val1b"),
+		event(EventTypeCreate, "foo/key3", "val3"),
+		event(EventTypeDelete, "foo/key2", ""),
+	})
+
+	// The pending events shall be emitted upon watcher termination, before
+	// closing the output channel.
+	w.Events <- event(EventTypeDelete, "foo/key3", "")
+	close(w.Events)
+
+	expect([]KeyValueEvent{event(EventTypeDelete, "foo/key3", "")})
+
+	_, ok := <-bw.Events
+	require.False(t, ok, "The batched events channel shall be closed")
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// NewReadThroughCache wraps the given backend with a client-side cache for
+// the keys matching the given prefixes (e.g., cluster configurations and
+// heartbeats), so that the redundant ranged gets performed by periodic
+// controllers are served locally. The cache is kept up-to-date by a watch
+// per prefix, which both invalidates and refreshes the entries; keys outside
+// the given prefixes, as well as all the other operations, are passed
+// through unmodified. Closing the returned backend stops the watches and
+// closes the underlying backend.
+func NewReadThroughCache(backend BackendOperations, prefixes ...string) BackendOperations {
+	ctx, cancel := context.WithCancel(context.Background())
+	rtc := &readThroughCache{
+		BackendOperations: backend,
+		prefixes:          prefixes,
+		entries:           make(map[string][]byte),
+		stop:              cancel,
+	}
+
+	for _, prefix := range prefixes {
+		rtc.wg.Add(1)
+		go func(prefix string) {
+			defer rtc.wg.Done()
+			rtc.watchPrefix(ctx, prefix)
+		}(prefix)
+	}
+
+	return rtc
+}
+
+type readThroughCache struct {
+	BackendOperations
+
+	prefixes []string
+	stop     context.CancelFunc
+	wg       sync.WaitGroup
+
+	mutex   lock.RWMutex
+	entries map[string][]byte
+}
+
+// watchPrefix keeps the cached entries for the given prefix up-to-date based
+// on the events received from the underlying backend.
+func (rtc *readThroughCache) watchPrefix(ctx context.Context, prefix string) {
+	watcher := rtc.BackendOperations.ListAndWatch(ctx, prefix, 16)
+	for event := range watcher.Events {
+		switch event.Typ {
+		case EventTypeCreate, EventTypeModify:
+			rtc.mutex.Lock()
+			rtc.entries[event.Key] = slices.Clone(event.Value)
+			rtc.mutex.Unlock()
+		case EventTypeDelete:
+			rtc.mutex.Lock()
+			delete(rtc.entries, event.Key)
+			rtc.mutex.Unlock()
+		}
+	}
+}
+
+// cacheable returns whether the given key falls under one of the cached prefixes.
+func (rtc *readThroughCache) cacheable(key string) bool {
+	for _, prefix := range rtc.prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Get returns the value of the given key, served from the cache when
+// possible. Cache misses fall through to the underlying backend, and
+// populate the cache for subsequent reads.
+func (rtc *readThroughCache) Get(ctx context.Context, key string) ([]byte, error) {
+	if !rtc.cacheable(key) {
+		return rtc.BackendOperations.Get(ctx, key)
+	}
+
+	rtc.mutex.RLock()
+	value, ok := rtc.entries[key]
+	rtc.mutex.RUnlock()
+	if ok {
+		return slices.Clone(value), nil
+	}
+
+	value, err := rtc.BackendOperations.Get(ctx, key)
+	if err == nil && value != nil {
+		rtc.mutex.Lock()
+		rtc.entries[key] = slices.Clone(value)
+		rtc.mutex.Unlock()
+	}
+
+	return value, err
+}
+
+// Close stops the invalidation watches and closes the underlying backend.
+func (rtc *readThroughCache) Close(ctx context.Context) {
+	rtc.stop()
+	rtc.wg.Wait()
+	rtc.BackendOperations.Close(ctx)
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+type cacheFakeBackend struct {
+	BackendOperations
+
+	mutex  lock.Mutex
+	values map[string][]byte
+	gets   map[string]int
+	events chan KeyValueEvent
+}
+
+func newCacheFakeBackend() *cacheFakeBackend {
+	return &cacheFakeBackend{
+		values: make(map[string][]byte),
+		gets:   make(map[string]int),
+		events: make(chan KeyValueEvent),
+	}
+}
+
+func (f *cacheFakeBackend) Get(_ context.Context, key string) ([]byte, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.gets[key]++
+	return f.values[key], nil
+}
+
+func (f *cacheFakeBackend) ListAndWatch(ctx context.Context, _ string, _ int) *Watcher {
+	events := make(chan KeyValueEvent)
+	go func() {
+		defer close(events)
+		for {
+			select {
+			case event := <-f.events:
+				events <- event
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return &Watcher{Events: events}
+}
+
+func (f *cacheFakeBackend) Close(context.Context) {}
+
+func (f *cacheFakeBackend) set(key string, value []byte) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.values[key] = value
+}
+
+func (f *cacheFakeBackend) getCount(key string) int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.gets[key]
+}
+
+func TestReadThroughCache(t *testing.T) {
+	const (
+		hot   = "cilium/cluster-config/foo"
+		other = "cilium/state/nodes/v1/bar"
+
+		tick    = 10 * time.Millisecond
+		timeout = 5 * time.Second
+	)
+
+	ctx := context.Background()
+	backend := newCacheFakeBackend()
+	backend.set(hot, []byte("value1"))
+	backend.set(other, []byte("other"))
+
+	cached := NewReadThroughCache(backend, ClusterConfigPrefix)
+	defer cached.Close(ctx)
+
+	// Keys outside the cached prefixes are always passed through.
+	for range 2 {
+		value, err := cached.Get(ctx, other)
+		require.NoError(t, err)
+		require.Equal(t, []byte("other"), value)
+	}
+	require.Equal(t, 2, backend.getCount(other))
+
+	// Keys under a cached prefix hit the backend only on the first read.
+	for range 3 {
+		value, err := cached.Get(ctx, hot)
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1"), value)
+	}
+	require.Equal(t, 1, backend.getCount(hot))
+
+	// A modification event refreshes the cached entry, without additional reads.
+	backend.set(hot, []byte("value2"))
+	backend.events <- KeyValueEvent{Typ: EventTypeModify, Key: hot, Value: []byte("value2")}
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		value, err := cached.Get(ctx, hot)
+		assert.NoError(c, err)
+		assert.Equal(c, []byte("value2"), value)
+	}, timeout, tick)
+	require.Equal(t, 1, backend.getCount(hot))
+
+	// A deletion event invalidates the cached entry, and subsequent reads
+	// fall through to the backend again.
+	backend.set(hot, nil)
+	backend.events <- KeyValueEvent{Typ: EventTypeDelete, Key: hot}
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		value, err := cached.Get(ctx, hot)
+		assert.NoError(c, err)
+		assert.Nil(c, value)
+	}, timeout, tick)
+	require.Greater(t, backend.getCount(hot), 1)
+}
@@ -27,6 +27,10 @@ func initClient(ctx context.Context, module backendModule, opts *ExtraOptions) e
 		scopedLog.WithError(err).Fatal("Unable to create kvstore client")
 	}
 
+	if opts != nil && opts.Namespace != "" {
+		c = NewNamespacedBackend(c, opts.Namespace)
+	}
+
 	defaultClient = c
 	select {
 	case <-defaultClientSet:
@@ -77,7 +81,12 @@ func NewClient(ctx context.Context, selectedBackend string, opts map[string]stri
 		return nil, errChan
 	}
 
-	return module.newClient(ctx, options)
+	backend, errCh := module.newClient(ctx, options)
+	if backend != nil && options != nil && options.Namespace != "" {
+		backend = NewNamespacedBackend(backend, options.Namespace)
+	}
+
+	return backend, errCh
 }
 
 // Connected returns a channel which is closed when the following conditions
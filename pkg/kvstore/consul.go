@@ -349,6 +349,7 @@ func (c *consulClient) watch(ctx context.Context, w *Watcher) {
 						newPair.Key, newPair.CreateIndex, newPair.ModifyIndex)
 				}
 
+				trackEventQueueDepth(newPair.Key, len(w.Events))
 				queueStart := spanstat.Start()
 				w.Events <- KeyValueEvent{
 					Typ:   EventTypeCreate,
@@ -357,6 +358,7 @@ func (c *consulClient) watch(ctx context.Context, w *Watcher) {
 				}
 				trackEventQueued(newPair.Key, EventTypeCreate, queueStart.End(true).Total())
 			} else if oldPair.ModifyIndex != newPair.ModifyIndex {
+				trackEventQueueDepth(newPair.Key, len(w.Events))
 				queueStart := spanstat.Start()
 				w.Events <- KeyValueEvent{
 					Typ:   EventTypeModify,
@@ -373,6 +375,7 @@ func (c *consulClient) watch(ctx context.Context, w *Watcher) {
 		}
 
 		for k, deletedPair := range localState {
+			trackEventQueueDepth(deletedPair.Key, len(w.Events))
 			queueStart := spanstat.Start()
 			w.Events <- KeyValueEvent{
 				Typ:   EventTypeDelete,
@@ -688,6 +691,11 @@ func (c *consulClient) StatusCheckErrors() <-chan error {
 // RegisterLeaseExpiredObserver is not implemented for the consul backend
 func (c *consulClient) RegisterLeaseExpiredObserver(prefix string, fn func(key string)) {}
 
+// Txn is not implemented for the consul backend
+func (c *consulClient) Txn(ctx context.Context, conditions []TxnCondition, ops []TxnOp) (bool, error) {
+	return false, ErrNotImplemented
+}
+
 // UserEnforcePresence is not implemented for the consul backend
 func (c *consulClient) UserEnforcePresence(ctx context.Context, name string, roles []string) error {
 	return ErrNotImplemented
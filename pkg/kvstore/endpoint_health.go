@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/cilium/cilium/pkg/lock"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+// scoreSmoothingFactor is the weight given to the most recent observation
+// when updating the moving averages of an endpoint score.
+const scoreSmoothingFactor = 0.25
+
+// endpointHealth tracks the heartbeat latency and error rate of each etcd
+// endpoint, as observed by the periodic status probes, to prefer healthy
+// endpoints when establishing new streams and to ease troubleshooting uneven
+// etcd member performance.
+type endpointHealth struct {
+	mutex  lock.RWMutex
+	scores map[string]*endpointScore
+}
+
+// endpointScore holds the exponentially weighted moving averages of the
+// heartbeat latency and error rate of one endpoint.
+type endpointScore struct {
+	latency   time.Duration
+	errorRate float64
+}
+
+func (es *endpointScore) String() string {
+	return fmt.Sprintf("latency=%v, error-rate=%.0f%%", es.latency.Round(time.Millisecond), es.errorRate*100)
+}
+
+func newEndpointHealth() *endpointHealth {
+	return &endpointHealth{scores: make(map[string]*endpointScore)}
+}
+
+// observe records the outcome of one status probe for the given endpoint, and
+// returns the updated score.
+func (eh *endpointHealth) observe(endpoint string, latency time.Duration, err error) *endpointScore {
+	eh.mutex.Lock()
+	defer eh.mutex.Unlock()
+
+	score, ok := eh.scores[endpoint]
+	if !ok {
+		score = &endpointScore{latency: latency}
+		eh.scores[endpoint] = score
+	}
+
+	var failure float64
+	if err != nil {
+		failure = 1
+	}
+
+	score.latency = time.Duration((1-scoreSmoothingFactor)*float64(score.latency) + scoreSmoothingFactor*float64(latency))
+	score.errorRate = (1-scoreSmoothingFactor)*score.errorRate + scoreSmoothingFactor*failure
+	return score
+}
+
+// rank returns the given endpoints sorted from the healthiest to the least
+// healthy one, that is by increasing error rate first, and increasing
+// heartbeat latency second. Endpoints never observed so far preserve their
+// relative position.
+func (eh *endpointHealth) rank(endpoints []string) []string {
+	eh.mutex.RLock()
+	defer eh.mutex.RUnlock()
+
+	ranked := slices.Clone(endpoints)
+	slices.SortStableFunc(ranked, func(a, b string) int {
+		sa, sb := eh.scores[a], eh.scores[b]
+		switch {
+		case sa == nil || sb == nil:
+			return 0
+		case sa.errorRate != sb.errorRate:
+			return cmp.Compare(sa.errorRate, sb.errorRate)
+		default:
+			return cmp.Compare(sa.latency, sb.latency)
+		}
+	})
+	return ranked
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/time"
+)
+
+func TestEndpointHealth(t *testing.T) {
+	eh := newEndpointHealth()
+	endpoints := []string{"https://etcd-1:2379", "https://etcd-2:2379", "https://etcd-3:2379"}
+
+	// Endpoints never observed so far shall preserve their relative position.
+	require.Equal(t, endpoints, eh.rank(endpoints))
+
+	for i := 0; i < 10; i++ {
+		eh.observe(endpoints[0], 10*time.Millisecond, nil)
+		eh.observe(endpoints[1], 50*time.Millisecond, nil)
+		eh.observe(endpoints[2], 10*time.Millisecond, errors.New("connection refused"))
+	}
+
+	// Endpoints shall be ranked by increasing error rate first, and
+	// increasing heartbeat latency second.
+	require.Equal(t,
+		[]string{"https://etcd-1:2379", "https://etcd-2:2379", "https://etcd-3:2379"},
+		eh.rank([]string{"https://etcd-3:2379", "https://etcd-2:2379", "https://etcd-1:2379"}))
+
+	// The error rate shall recover once the probes start succeeding again.
+	for i := 0; i < 25; i++ {
+		eh.observe(endpoints[2], 10*time.Millisecond, nil)
+	}
+
+	eh.observe(endpoints[1], 50*time.Millisecond, errors.New("context deadline exceeded"))
+
+	require.Equal(t,
+		[]string{"https://etcd-1:2379", "https://etcd-3:2379", "https://etcd-2:2379"},
+		eh.rank(endpoints))
+}
@@ -6,12 +6,19 @@ package kvstore
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"math/rand/v2"
+	"net"
 	"net/url"
 	"os"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -26,6 +33,8 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	grpcbackoff "google.golang.org/grpc/backoff"
 	"sigs.k8s.io/yaml"
 
 	"github.com/cilium/cilium/pkg/backoff"
@@ -50,6 +59,37 @@ const (
 	EtcdOptionKeepAliveHeartbeat = "etcd.keepaliveHeartbeat"
 	EtcdOptionKeepAliveTimeout   = "etcd.keepaliveTimeout"
 
+	// EtcdOptionDialBackoffBase specifies the initial delay before retrying
+	// a failed connection attempt to an etcd endpoint
+	EtcdOptionDialBackoffBase = "etcd.dialBackoffBase"
+
+	// EtcdOptionDialBackoffMax specifies the maximum delay before retrying
+	// a failed connection attempt to an etcd endpoint
+	EtcdOptionDialBackoffMax = "etcd.dialBackoffMax"
+
+	// EtcdOptionAdaptiveRateLimit enables automatic adjustment of the rate
+	// limit based on the observed etcd latency, to back off when etcd is
+	// struggling (e.g., while a large agent fleet is resyncing). The value
+	// configured through EtcdRateLimitOption is used as starting point.
+	EtcdOptionAdaptiveRateLimit = "etcd.adaptiveQps"
+
+	// EtcdOptionTargetLatency specifies the target latency of etcd
+	// operations the adaptive rate limiter aims for
+	EtcdOptionTargetLatency = "etcd.targetLatency"
+
+	// EtcdOptionFailoverEndpoints specifies the comma-separated list of
+	// endpoints of a standby etcd cluster the client fails over to upon
+	// sustained failure of the primary one, failing back when it recovers.
+	// Failing over implies a full resync, as the standby cluster is not
+	// expected to share the revision history of the primary one.
+	EtcdOptionFailoverEndpoints = "etcd.failoverEndpoints"
+
+	// EtcdOptionSerializablePrefixes specifies the comma-separated list of
+	// key prefixes whose read operations are served with serializable (i.e.,
+	// possibly stale) consistency, to reduce the quorum read load caused by
+	// non-critical paths on large etcd clusters
+	EtcdOptionSerializablePrefixes = "etcd.serializablePrefixes"
+
 	// EtcdRateLimitOption specifies maximum kv operations per second
 	EtcdRateLimitOption = "etcd.qps"
 
@@ -127,6 +167,40 @@ func newEtcdModule() backendModule {
 					return err
 				},
 			},
+			EtcdOptionDialBackoffBase: &backendOption{
+				description: "Initial delay before retrying a failed connection attempt to an etcd endpoint",
+				validate: func(v string) error {
+					_, err := time.ParseDuration(v)
+					return err
+				},
+			},
+			EtcdOptionDialBackoffMax: &backendOption{
+				description: "Maximum delay before retrying a failed connection attempt to an etcd endpoint",
+				validate: func(v string) error {
+					_, err := time.ParseDuration(v)
+					return err
+				},
+			},
+			EtcdOptionAdaptiveRateLimit: &backendOption{
+				description: "Automatically adjust the rate limit based on the observed etcd latency",
+				validate: func(v string) error {
+					_, err := strconv.ParseBool(v)
+					return err
+				},
+			},
+			EtcdOptionTargetLatency: &backendOption{
+				description: "Target latency of etcd operations the adaptive rate limiter aims for",
+				validate: func(v string) error {
+					_, err := time.ParseDuration(v)
+					return err
+				},
+			},
+			EtcdOptionFailoverEndpoints: &backendOption{
+				description: "Comma-separated list of endpoints of a standby etcd cluster to fail over to",
+			},
+			EtcdOptionSerializablePrefixes: &backendOption{
+				description: "Comma-separated list of key prefixes whose reads tolerate serializable (possibly stale) consistency",
+			},
 			EtcdRateLimitOption: &backendOption{
 				description: "Rate limit in kv store operations per second",
 				validate: func(v string) error {
@@ -195,12 +269,18 @@ func shuffleEndpoints(endpoints []string) {
 }
 
 type clientOptions struct {
-	KeepAliveHeartbeat time.Duration
-	KeepAliveTimeout   time.Duration
-	RateLimit          int
-	BootstrapRateLimit int
-	MaxInflight        int
-	ListBatchSize      int
+	KeepAliveHeartbeat   time.Duration
+	KeepAliveTimeout     time.Duration
+	DialBackoffBase      time.Duration
+	DialBackoffMax       time.Duration
+	RateLimit            int
+	BootstrapRateLimit   int
+	MaxInflight          int
+	ListBatchSize        int
+	SerializablePrefixes []string
+	AdaptiveRateLimit    bool
+	TargetLatency        time.Duration
+	FailoverEndpoints    []string
 }
 
 func (e *etcdModule) newClient(ctx context.Context, opts *ExtraOptions) (BackendOperations, chan error) {
@@ -241,6 +321,30 @@ func (e *etcdModule) newClient(ctx context.Context, opts *ExtraOptions) (Backend
 		clientOptions.KeepAliveHeartbeat, _ = time.ParseDuration(o.value)
 	}
 
+	if o, ok := e.opts[EtcdOptionDialBackoffBase]; ok && o.value != "" {
+		clientOptions.DialBackoffBase, _ = time.ParseDuration(o.value)
+	}
+
+	if o, ok := e.opts[EtcdOptionDialBackoffMax]; ok && o.value != "" {
+		clientOptions.DialBackoffMax, _ = time.ParseDuration(o.value)
+	}
+
+	if o, ok := e.opts[EtcdOptionSerializablePrefixes]; ok && o.value != "" {
+		clientOptions.SerializablePrefixes = strings.Split(o.value, ",")
+	}
+
+	if o, ok := e.opts[EtcdOptionFailoverEndpoints]; ok && o.value != "" {
+		clientOptions.FailoverEndpoints = strings.Split(o.value, ",")
+	}
+
+	if o, ok := e.opts[EtcdOptionAdaptiveRateLimit]; ok && o.value != "" {
+		clientOptions.AdaptiveRateLimit, _ = strconv.ParseBool(o.value)
+	}
+
+	if o, ok := e.opts[EtcdOptionTargetLatency]; ok && o.value != "" {
+		clientOptions.TargetLatency, _ = time.ParseDuration(o.value)
+	}
+
 	endpointsOpt, endpointsSet := e.opts[EtcdAddrOption]
 	configPathOpt, configSet := e.opts[EtcdOptionConfig]
 
@@ -276,6 +380,8 @@ func (e *etcdModule) newClient(ctx context.Context, opts *ExtraOptions) (Backend
 		"RateLimit":          clientOptions.RateLimit,
 		"MaxInflight":        clientOptions.MaxInflight,
 		"ListLimit":          clientOptions.ListBatchSize,
+		"DialBackoffBase":    clientOptions.DialBackoffBase,
+		"DialBackoffMax":     clientOptions.DialBackoffMax,
 	}).Info("Creating etcd client")
 
 	for {
@@ -382,8 +488,24 @@ type etcdClient struct {
 
 	extraOptions *ExtraOptions
 
-	limiter       *ciliumrate.APILimiter
-	listBatchSize int
+	limiter              *ciliumrate.APILimiter
+	listBatchSize        int
+	serializablePrefixes []string
+
+	// primaryEndpoints and failoverEndpoints are initialized once and never
+	// written to again. failoverActive is only accessed by statusChecker().
+	primaryEndpoints  []string
+	failoverEndpoints []string
+	failoverActive    bool
+
+	// forceResync is closed and replaced to force all watchers to fully
+	// re-list their prefix after switching to a different etcd cluster.
+	// Protected by RWMutex.
+	forceResync chan struct{}
+
+	// endpointHealth tracks per-endpoint liveness scores based on the
+	// outcome of the periodic status probes
+	endpointHealth *endpointHealth
 
 	lastHeartbeat time.Time
 
@@ -407,6 +529,15 @@ func (e *etcdMutex) Comparator() interface{} {
 	return e.mutex.IsOwner()
 }
 
+// FencingToken returns the etcd revision the lock was acquired at, which is
+// guaranteed to increase across subsequent acquisitions of the same lock.
+func (e *etcdMutex) FencingToken() FencingToken {
+	if hdr := e.mutex.Header(); hdr != nil {
+		return FencingToken(hdr.Revision)
+	}
+	return 0
+}
+
 // StatusCheckErrors returns a channel which receives status check errors
 func (e *etcdClient) StatusCheckErrors() <-chan error {
 	return e.statusCheckErrors
@@ -530,19 +661,37 @@ func connectEtcdClient(ctx context.Context, config *client.Config, cfgPath strin
 			return nil, err
 		}
 		if cfg.TLS != nil {
-			cfg.TLS.GetClientCertificate, err = getClientCertificateReloader(cfgPath)
-			if err != nil {
+			if err := setupCertificateReloading(cfg, cfgPath); err != nil {
 				return nil, err
 			}
 		}
 		cfg.DialOptions = append(cfg.DialOptions, config.DialOptions...)
+
+		// Discover the client endpoints through DNS SRV records when no
+		// static endpoints are configured.
+		if len(cfg.Endpoints) == 0 {
+			domain, err := EtcdDiscoverySrv(cfgPath)
+			if err != nil {
+				return nil, err
+			}
+			if domain != "" {
+				cfg.Endpoints, err = SrvDiscoveryEndpoints(ctx, domain)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
 		config = cfg
 	}
 
-	// Shuffle the order of endpoints to avoid all agents connecting to the
-	// same etcd endpoint and to work around etcd client library failover
-	// bugs. (https://github.com/etcd-io/etcd/pull/9860)
-	if config.Endpoints != nil {
+	if opts != nil && len(opts.Endpoints) > 0 {
+		// The caller explicitly selected the endpoints (and their order).
+		config.Endpoints = slices.Clone(opts.Endpoints)
+	} else if config.Endpoints != nil {
+		// Shuffle the order of endpoints to avoid all agents connecting to the
+		// same etcd endpoint and to work around etcd client library failover
+		// bugs. (https://github.com/etcd-io/etcd/pull/9860)
 		shuffleEndpoints(config.Endpoints)
 	}
 
@@ -552,11 +701,31 @@ func connectEtcdClient(ctx context.Context, config *client.Config, cfgPath strin
 	// block until DialTimeout is reached or a connection to the server
 	// is made.
 	config.DialTimeout = 0
-	// Ping the server to verify if the server connection is still valid
-	config.DialKeepAliveTime = clientOptions.KeepAliveHeartbeat
+	// Ping the server to verify if the server connection is still valid.
+	// Honor the value from the configuration file, when present, so that
+	// it can be tuned for each remote cluster independently.
+	if config.DialKeepAliveTime == 0 {
+		config.DialKeepAliveTime = clientOptions.KeepAliveHeartbeat
+	}
 	// Timeout if the server does not reply within 15 seconds and close the
 	// connection. Ideally it should be lower than staleLockTimeout
-	config.DialKeepAliveTimeout = clientOptions.KeepAliveTimeout
+	if config.DialKeepAliveTimeout == 0 {
+		config.DialKeepAliveTimeout = clientOptions.KeepAliveTimeout
+	}
+
+	// Override the default gRPC backoff policy for reconnection attempts,
+	// to prevent silent half-open connections behind aggressive middleboxes.
+	if clientOptions.DialBackoffBase != 0 || clientOptions.DialBackoffMax != 0 {
+		bkoff := grpcbackoff.DefaultConfig
+		if clientOptions.DialBackoffBase != 0 {
+			bkoff.BaseDelay = clientOptions.DialBackoffBase
+		}
+		if clientOptions.DialBackoffMax != 0 {
+			bkoff.MaxDelay = clientOptions.DialBackoffMax
+		}
+		config.DialOptions = append(config.DialOptions,
+			grpc.WithConnectParams(grpc.ConnectParams{Backoff: bkoff}))
+	}
 
 	// Use the shared etcd client logger to prevent unnecessary allocations.
 	config.Logger = etcd3ClientLogger
@@ -577,6 +746,11 @@ func connectEtcdClient(ctx context.Context, config *client.Config, cfgPath strin
 		stopStatusChecker:    make(chan struct{}),
 		extraOptions:         opts,
 		listBatchSize:        clientOptions.ListBatchSize,
+		serializablePrefixes: clientOptions.SerializablePrefixes,
+		primaryEndpoints:     config.Endpoints,
+		failoverEndpoints:    clientOptions.FailoverEndpoints,
+		forceResync:          make(chan struct{}),
+		endpointHealth:       newEndpointHealth(),
 		statusCheckErrors:    make(chan error, 128),
 		logger: log.WithFields(logrus.Fields{
 			"endpoints": config.Endpoints,
@@ -601,11 +775,28 @@ func connectEtcdClient(ctx context.Context, config *client.Config, cfgPath strin
 		}()
 	}
 
-	ec.limiter = ciliumrate.NewAPILimiter(makeSessionName("etcd", opts), ciliumrate.APILimiterParameters{
+	limiterParams := ciliumrate.APILimiterParameters{
 		RateLimit:        rate.Limit(initialLimit),
 		RateBurst:        clientOptions.RateLimit,
 		ParallelRequests: clientOptions.MaxInflight,
-	}, ciliumratemetrics.APILimiterObserver())
+	}
+
+	if clientOptions.AdaptiveRateLimit {
+		// Automatically adjust the rate limit based on the observed latency
+		// of etcd operations, backing off when etcd is struggling to keep
+		// up. The configured rate limit is used as starting point.
+		targetLatency := clientOptions.TargetLatency
+		if targetLatency == 0 {
+			targetLatency = 100 * time.Millisecond
+		}
+
+		limiterParams.AutoAdjust = true
+		limiterParams.EstimatedProcessingDuration = targetLatency
+		limiterParams.MinParallelRequests = 1
+		limiterParams.MaxParallelRequests = clientOptions.MaxInflight
+	}
+
+	ec.limiter = ciliumrate.NewAPILimiter(makeSessionName("etcd", opts), limiterParams, ciliumratemetrics.APILimiterObserver())
 
 	ec.logger.Info("Connecting to etcd server...")
 
@@ -817,11 +1008,17 @@ reList:
 		default:
 		}
 
+		// Retrieve the channel before listing, so that a forced resync
+		// triggered while the list is in progress is not missed.
+		forcedResync := e.forcedResyncChannel()
+
 		lr, err := e.limiter.Wait(ctx)
 		if err != nil {
 			continue
 		}
+		duration := spanstat.Start()
 		kvs, revision, err := e.paginatedList(ctx, scopedLog, w.Prefix)
+		increaseMetric(w.Prefix, metricRead, "ListAndWatch", duration.EndError(err).Total(), err)
 		if err != nil {
 			lr.Error(err, -1)
 			scopedLog.WithError(Hint(err)).Warn("Unable to list keys before starting watcher")
@@ -843,6 +1040,7 @@ reList:
 				scopedLog.Debugf("Emitting list result as %s event for %s=%s", t, key.Key, key.Value)
 			}
 
+			trackEventQueueDepth(string(key.Key), len(w.Events))
 			queueStart := spanstat.Start()
 			w.Events <- KeyValueEvent{
 				Key:   string(key.Key),
@@ -867,6 +1065,7 @@ reList:
 				scopedLog.Debugf("Emitting EventTypeDelete event for %s", k)
 			}
 
+			trackEventQueueDepth(k, len(w.Events))
 			queueStart := spanstat.Start()
 			w.Events <- event
 			trackEventQueued(k, EventTypeDelete, queueStart.End(true).Total())
@@ -905,6 +1104,19 @@ reList:
 				return
 			case <-w.stopWatch:
 				return
+			case <-forcedResync:
+				// The endpoints were switched to a different etcd
+				// cluster: the watch would wait silently at a revision
+				// the new cluster may never reach, so re-list to
+				// resynchronize.
+				scopedLog.Info("Forced re-list requested, restarting watcher")
+
+				// mark all local keys in state for
+				// deletion unless the upcoming GET
+				// marks them alive
+				localCache.MarkAllForDeletion()
+
+				goto reList
 			case r, ok := <-etcdWatch:
 				if !ok {
 					time.Sleep(50 * time.Millisecond)
@@ -915,19 +1127,28 @@ reList:
 
 				if err := r.Err(); err != nil {
 					// We tried to watch on a compacted
-					// revision that may no longer exist,
-					// recreate the watcher and try to
-					// watch on the next possible revision
+					// revision that may no longer exist:
+					// the events in between have been
+					// lost, and a full re-list is
+					// required to resynchronize
 					if errors.Is(err, v3rpcErrors.ErrCompacted) {
 						scopedLog.WithError(Hint(err)).Debug("Tried watching on compacted revision")
-					}
 
-					// mark all local keys in state for
-					// deletion unless the upcoming GET
-					// marks them alive
-					localCache.MarkAllForDeletion()
+						// mark all local keys in state for
+						// deletion unless the upcoming GET
+						// marks them alive
+						localCache.MarkAllForDeletion()
+
+						goto reList
+					}
 
-					goto reList
+					// The failure is transient: resume the
+					// watch from the last seen revision, as
+					// the missed events are replayed by etcd,
+					// avoiding the cost of a full re-list
+					scopedLog.WithError(Hint(err)).Debug("Watch channel failed, resuming from last seen revision")
+					time.Sleep(50 * time.Millisecond)
+					goto recreateWatcher
 				}
 
 				nextRev = r.Header.Revision + 1
@@ -957,6 +1178,7 @@ reList:
 						scopedLog.Debugf("Emitting %s event for %s=%s", event.Typ, event.Key, event.Value)
 					}
 
+					trackEventQueueDepth(string(ev.Kv.Key), len(w.Events))
 					queueStart := spanstat.Start()
 					w.Events <- event
 					trackEventQueued(string(ev.Kv.Key), event.Typ, queueStart.End(true).Total())
@@ -968,13 +1190,17 @@ reList:
 
 func (e *etcdClient) paginatedList(ctx context.Context, log *logrus.Entry, prefix string) (kvs []*mvccpb.KeyValue, revision int64, err error) {
 	start, end := prefix, client.GetPrefixRangeEnd(prefix)
+	batchSize := listLimit(ctx, e.listBatchSize)
 
 	for {
-		res, err := e.client.Get(ctx, start, client.WithRange(end),
+		pctx, cancel := operationTimeout(ctx)
+		res, err := e.client.Get(pctx, start, client.WithRange(end),
 			client.WithSort(client.SortByKey, client.SortAscend),
 			client.WithRev(revision), client.WithSerializable(),
-			client.WithLimit(int64(e.listBatchSize)),
+			client.WithLimit(int64(batchSize)),
 		)
+		cancel()
+		trackListPage(prefix, err)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -1005,9 +1231,11 @@ func (e *etcdClient) determineEndpointStatus(ctx context.Context, endpointAddres
 
 	e.logger.Debugf("Checking status to etcd endpoint %s", endpointAddress)
 
+	duration := spanstat.Start()
 	status, err := e.client.Status(ctxTimeout, endpointAddress)
+	score := e.endpointHealth.observe(endpointAddress, duration.EndError(err).Total(), err)
 	if err != nil {
-		return fmt.Sprintf("%s - %s", endpointAddress, err), Hint(err)
+		return fmt.Sprintf("%s - %s (%s)", endpointAddress, err, score), Hint(err)
 	}
 
 	str := fmt.Sprintf("%s - %s", endpointAddress, status.Version)
@@ -1015,7 +1243,7 @@ func (e *etcdClient) determineEndpointStatus(ctx context.Context, endpointAddres
 		str += " (Leader)"
 	}
 
-	return str, nil
+	return fmt.Sprintf("%s (%s)", str, score), nil
 }
 
 func (e *etcdClient) statusChecker() {
@@ -1057,6 +1285,17 @@ func (e *etcdClient) statusChecker() {
 
 				newStatus = append(newStatus, st)
 			}
+
+			// Reorder the endpoints from the healthiest to the least
+			// healthy one, so that it is preferred when establishing
+			// new streams.
+			if !e.failoverActive && len(endpoints) > 1 {
+				if ranked := e.endpointHealth.rank(endpoints); !slices.Equal(ranked, endpoints) {
+					e.logger.WithField("endpoints", ranked).
+						Debug("Reordering etcd endpoints based on liveness scores")
+					e.client.SetEndpoints(ranked...)
+				}
+			}
 		}
 
 		allConnected := len(endpoints) == ok
@@ -1070,6 +1309,10 @@ func (e *etcdClient) statusChecker() {
 			consecutiveQuorumErrors = 0
 		}
 
+		if len(e.failoverEndpoints) > 0 {
+			e.reconcileFailover(ctx, consecutiveQuorumErrors)
+		}
+
 		e.statusLock.Lock()
 
 		switch {
@@ -1086,6 +1329,14 @@ func (e *etcdClient) statusChecker() {
 				ok, len(endpoints), e.leaseManager.TotalLeases(), e.lockLeaseManager.TotalLeases(), quorumString, strings.Join(newStatus, "; "))
 		}
 
+		if len(e.failoverEndpoints) > 0 {
+			active := "primary"
+			if e.failoverActive {
+				active = "failover"
+			}
+			e.latestStatusSnapshot += fmt.Sprintf(", active-cluster=%s", active)
+		}
+
 		e.statusLock.Unlock()
 		if e.latestErrorStatus != nil {
 			select {
@@ -1106,6 +1357,60 @@ func (e *etcdClient) statusChecker() {
 	}
 }
 
+// reconcileFailover fails over to the standby etcd cluster upon sustained
+// failure of the primary one, and fails back when it recovers. Switching the
+// endpoints forces a full re-list of all watched prefixes: the revision
+// histories of the two clusters are unrelated, and a watch registered at a
+// revision the other cluster has not reached yet would otherwise wait
+// silently, serving stale data forever.
+func (e *etcdClient) reconcileFailover(ctx context.Context, consecutiveQuorumErrors uint) {
+	if !e.failoverActive {
+		if consecutiveQuorumErrors > option.Config.KVstoreMaxConsecutiveQuorumErrors {
+			e.logger.WithField("endpoints", e.failoverEndpoints).
+				Warning("Sustained failure of the primary etcd cluster, failing over to the standby one")
+			e.client.SetEndpoints(e.failoverEndpoints...)
+			e.failoverActive = true
+			recordFailoverActive(true)
+			e.triggerForcedResync()
+		}
+		return
+	}
+
+	// Probe the primary cluster, and fail back as soon as one of its
+	// endpoints turns healthy again.
+	for _, ep := range e.primaryEndpoints {
+		cctx, cancel := context.WithTimeout(ctx, statusCheckTimeout)
+		_, err := e.client.Status(cctx, ep)
+		cancel()
+
+		if err == nil {
+			e.logger.WithField("endpoints", e.primaryEndpoints).
+				Info("Primary etcd cluster recovered, failing back")
+			e.client.SetEndpoints(e.primaryEndpoints...)
+			e.failoverActive = false
+			recordFailoverActive(false)
+			e.triggerForcedResync()
+			return
+		}
+	}
+}
+
+// triggerForcedResync makes all watchers re-list their prefix and recreate
+// their watch stream from the retrieved revision.
+func (e *etcdClient) triggerForcedResync() {
+	e.RWMutex.Lock()
+	close(e.forceResync)
+	e.forceResync = make(chan struct{})
+	e.RWMutex.Unlock()
+}
+
+// forcedResyncChannel returns the channel closed by the next forced resync.
+func (e *etcdClient) forcedResyncChannel() <-chan struct{} {
+	e.RWMutex.RLock()
+	defer e.RWMutex.RUnlock()
+	return e.forceResync
+}
+
 func (e *etcdClient) Status() (string, error) {
 	e.statusLock.RLock()
 	defer e.statusLock.RUnlock()
@@ -1147,11 +1452,27 @@ func (e *etcdClient) GetIfLocked(ctx context.Context, key string, lock KVLocker)
 	return getR.Kvs[0].Value, nil
 }
 
+// readOpts returns the additional etcd options associated with the given read
+// operation. Specifically, it enables serializable (i.e., possibly stale)
+// reads for the keys matching one of the configured prefixes, so that they
+// can be served by any etcd member without requiring quorum. Reads feeding
+// write decisions (e.g., allocation) shall not use these options.
+func (e *etcdClient) readOpts(key string) []client.OpOption {
+	for _, prefix := range e.serializablePrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return []client.OpOption{client.WithSerializable()}
+		}
+	}
+	return nil
+}
+
 // Get returns value of key
 func (e *etcdClient) Get(ctx context.Context, key string) (bv []byte, err error) {
 	defer func() {
 		Trace("Get", err, logrus.Fields{fieldKey: key, fieldValue: string(bv)})
 	}()
+	ctx, cancel := operationTimeout(ctx)
+	defer cancel()
 	lr, err := e.limiter.Wait(ctx)
 	if err != nil {
 		return nil, Hint(err)
@@ -1160,7 +1481,7 @@ func (e *etcdClient) Get(ctx context.Context, key string) (bv []byte, err error)
 		increaseMetric(key, metricRead, "Get", duration.EndError(err).Total(), err)
 	}(spanstat.Start())
 
-	getR, err := e.client.Get(ctx, key)
+	getR, err := e.client.Get(ctx, key, e.readOpts(key)...)
 	if err != nil {
 		lr.Error(err, -1)
 		return nil, Hint(err)
@@ -1206,6 +1527,8 @@ func (e *etcdClient) Delete(ctx context.Context, key string) (err error) {
 	defer func() {
 		Trace("Delete", err, logrus.Fields{fieldKey: key})
 	}()
+	ctx, cancel := operationTimeout(ctx)
+	defer cancel()
 	lr, err := e.limiter.Wait(ctx)
 	if err != nil {
 		return Hint(err)
@@ -1265,6 +1588,8 @@ func (e *etcdClient) Update(ctx context.Context, key string, value []byte, lease
 	defer func() {
 		Trace("Update", err, logrus.Fields{fieldKey: key, fieldValue: string(value), fieldAttachLease: lease})
 	}()
+	ctx, cancel := operationTimeout(ctx)
+	defer cancel()
 	var leaseID client.LeaseID
 	if lease {
 		leaseID, err = e.leaseManager.GetLeaseID(ctx, key)
@@ -1360,6 +1685,76 @@ func (e *etcdClient) UpdateIfDifferent(ctx context.Context, key string, value []
 	return false, nil
 }
 
+// Txn atomically applies the given operations if all the conditions hold,
+// returning whether the transaction succeeded.
+func (e *etcdClient) Txn(ctx context.Context, conditions []TxnCondition, ops []TxnOp) (success bool, err error) {
+	defer func() {
+		Trace("Txn", err, logrus.Fields{fieldNumEntries: len(ops), "success": success})
+	}()
+
+	cmps := make([]client.Cmp, 0, len(conditions))
+	for _, cnd := range conditions {
+		if cnd.Value == nil {
+			cmps = append(cmps, client.Compare(client.CreateRevision(cnd.Key), "=", 0))
+		} else {
+			cmps = append(cmps, client.Compare(client.Value(cnd.Key), "=", string(cnd.Value)))
+		}
+	}
+
+	var leaseIDs []client.LeaseID
+	txnOps := make([]client.Op, 0, len(ops))
+	for _, op := range ops {
+		if op.Delete {
+			txnOps = append(txnOps, client.OpDelete(op.Key))
+			continue
+		}
+
+		var leaseID client.LeaseID
+		if op.Lease {
+			leaseID, err = e.leaseManager.GetLeaseID(ctx, op.Key)
+			if err != nil {
+				return false, Hint(err)
+			}
+			leaseIDs = append(leaseIDs, leaseID)
+		}
+		txnOps = append(txnOps, client.OpPut(op.Key, string(op.Value), client.WithLease(leaseID)))
+	}
+
+	// Attribute the transaction to the scope of its first operation.
+	key := "txn"
+	if len(ops) > 0 {
+		key = ops[0].Key
+	}
+
+	lr, err := e.limiter.Wait(ctx)
+	if err != nil {
+		return false, Hint(err)
+	}
+	defer func(duration *spanstat.SpanStat) {
+		increaseMetric(key, metricSet, "Txn", duration.EndError(err).Total(), err)
+	}(spanstat.Start())
+
+	txnReply, err := e.client.Txn(ctx).If(cmps...).Then(txnOps...).Commit()
+	// Using lr.Error for convenience, as it matches lr.Done() when err is nil
+	lr.Error(err, -1)
+	if err != nil {
+		for _, leaseID := range leaseIDs {
+			e.leaseManager.CancelIfExpired(err, leaseID)
+		}
+		return false, Hint(err)
+	}
+
+	if txnReply.Succeeded {
+		for _, op := range ops {
+			if op.Delete {
+				e.leaseManager.Release(op.Key)
+			}
+		}
+	}
+
+	return txnReply.Succeeded, nil
+}
+
 // CreateOnlyIfLocked atomically creates a key if the client is still holding the given lock or fails if it already exists
 func (e *etcdClient) CreateOnlyIfLocked(ctx context.Context, key string, value []byte, lease bool, lock KVLocker) (success bool, err error) {
 	defer func() {
@@ -1503,6 +1898,8 @@ func (e *etcdClient) ListPrefix(ctx context.Context, prefix string) (v KeyValueP
 	defer func() {
 		Trace("ListPrefix", err, logrus.Fields{fieldPrefix: prefix, fieldNumEntries: len(v)})
 	}()
+	ctx, cancel := operationTimeout(ctx)
+	defer cancel()
 	lr, err := e.limiter.Wait(ctx)
 	if err != nil {
 		return nil, Hint(err)
@@ -1511,7 +1908,7 @@ func (e *etcdClient) ListPrefix(ctx context.Context, prefix string) (v KeyValueP
 		increaseMetric(prefix, metricRead, "ListPrefix", duration.EndError(err).Total(), err)
 	}(spanstat.Start())
 
-	getR, err := e.client.Get(ctx, prefix, client.WithPrefix())
+	getR, err := e.client.Get(ctx, prefix, append(e.readOpts(prefix), client.WithPrefix())...)
 	if err != nil {
 		lr.Error(err, -1)
 		return nil, Hint(err)
@@ -1733,6 +2130,226 @@ func newConfig(fpath string) (*client.Config, error) {
 	return cfg, nil
 }
 
+// setupCertificateReloading arranges for the TLS credentials referenced by
+// the given etcd configuration file to be re-read from disk when needed,
+// rather than being fixed at client creation time. The client certificate and
+// key are reloaded on every handshake, and the trusted CA pool is reloaded
+// whenever the CA file changes on disk. This allows certificate rotation to
+// take effect on the existing client, without requiring the configuration
+// file itself to change and the connection to be re-created.
+func setupCertificateReloading(cfg *client.Config, fpath string) error {
+	var err error
+	cfg.TLS.GetClientCertificate, err = getClientCertificateReloader(fpath)
+	if err != nil {
+		return err
+	}
+
+	yc := &yamlConfig{}
+	b, err := os.ReadFile(fpath)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(b, yc); err != nil {
+		return err
+	}
+
+	if yc.InsecureTransport || yc.InsecureSkipTLSVerify {
+		return nil
+	}
+
+	caFile := yc.TrustedCAfile
+	if caFile == "" {
+		caFile = yc.CAfile
+	}
+	if caFile == "" {
+		return nil
+	}
+
+	verifier := &caReloadingVerifier{path: caFile}
+	// Fail early at client creation time if the CA file cannot be loaded.
+	if _, err := verifier.getRoots(); err != nil {
+		return err
+	}
+
+	// The standard library verification is disabled in favour of the
+	// VerifyConnection callback, which performs the same chain and server
+	// name checks against the reloaded CA pool.
+	cfg.TLS.InsecureSkipVerify = true
+	cfg.TLS.VerifyConnection = verifier.verify
+	return nil
+}
+
+// caReloadingVerifier verifies the certificate chain presented by the etcd
+// server against a CA pool which is re-read from disk whenever the trusted CA
+// file changes, so that CA rotation takes effect on new handshakes of the
+// existing client.
+type caReloadingVerifier struct {
+	path string
+
+	mutex   lock.Mutex
+	modTime time.Time
+	roots   *x509.CertPool
+}
+
+func (v *caReloadingVerifier) getRoots() (*x509.CertPool, error) {
+	info, err := os.Stat(v.path)
+	if err != nil {
+		return nil, err
+	}
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if v.roots == nil || info.ModTime().After(v.modTime) {
+		cp, err := tlsutil.NewCertPool([]string{v.path})
+		if err != nil {
+			return nil, err
+		}
+		v.roots = cp
+		v.modTime = info.ModTime()
+
+		if notAfter, err := certificateExpiry(v.path); err == nil {
+			recordCertificateLifetime("ca", notAfter)
+		}
+	}
+
+	return v.roots, nil
+}
+
+// certificateExpiry returns the earliest expiration time of the PEM-encoded
+// certificates contained in the given file.
+func certificateExpiry(path string) (time.Time, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var earliest time.Time
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+
+		if earliest.IsZero() || cert.NotAfter.Before(earliest) {
+			earliest = cert.NotAfter
+		}
+	}
+
+	if earliest.IsZero() {
+		return time.Time{}, fmt.Errorf("no certificates found in %s", path)
+	}
+
+	return earliest, nil
+}
+
+func (v *caReloadingVerifier) verify(cs tls.ConnectionState) error {
+	roots, err := v.getRoots()
+	if err != nil {
+		return fmt.Errorf("unable to load trusted CA certificates from %s: %w", v.path, err)
+	}
+
+	opts := x509.VerifyOptions{
+		DNSName:       cs.ServerName,
+		Roots:         roots,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err = cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
+// EtcdDiscoverySrv returns the DNS SRV discovery domain specified in the
+// given etcd configuration file, or an empty string if not set, or static
+// endpoints are configured as well (which take precedence).
+func EtcdDiscoverySrv(cfgPath string) (string, error) {
+	yc := &yamlConfig{}
+	b, err := os.ReadFile(cfgPath)
+	if err != nil {
+		return "", err
+	}
+	if err := yaml.Unmarshal(b, yc); err != nil {
+		return "", err
+	}
+	if len(yc.Endpoints) > 0 {
+		return "", nil
+	}
+	return yc.DiscoverySrv, nil
+}
+
+// EtcdEndpoints returns the static client endpoints specified in the given
+// etcd configuration file, or an empty slice if none are configured (e.g.,
+// when DNS SRV discovery is used instead).
+func EtcdEndpoints(cfgPath string) ([]string, error) {
+	yc := &yamlConfig{}
+	b, err := os.ReadFile(cfgPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(b, yc); err != nil {
+		return nil, err
+	}
+	return yc.Endpoints, nil
+}
+
+// EtcdConfigDigest returns a digest of the material fields of the given etcd
+// configuration file (endpoints, discovery domain, credentials and TLS
+// settings), allowing to compare two versions of the file semantically,
+// ignoring modifications (e.g., to comments or field ordering) that do not
+// affect the resulting connection.
+func EtcdConfigDigest(cfgPath string) (string, error) {
+	yc := &yamlConfig{}
+	b, err := os.ReadFile(cfgPath)
+	if err != nil {
+		return "", err
+	}
+	if err := yaml.Unmarshal(b, yc); err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	fmt.Fprintln(h, strings.Join(yc.Endpoints, ","))
+	fmt.Fprintln(h, yc.DiscoverySrv)
+	fmt.Fprintln(h, yc.Username, yc.Password)
+	fmt.Fprintln(h, yc.InsecureTransport, yc.InsecureSkipTLSVerify)
+	fmt.Fprintln(h, yc.Certfile, yc.Keyfile, yc.TrustedCAfile, yc.CAfile)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// SrvDiscoveryEndpoints resolves the etcd client endpoints advertised through
+// DNS SRV records under the given domain, following the same naming scheme as
+// etcd DNS discovery (_etcd-client-ssl._tcp is preferred over
+// _etcd-client._tcp). The returned endpoints are sorted, so that two
+// resolutions of the same record set can be compared directly.
+func SrvDiscoveryEndpoints(ctx context.Context, domain string) ([]string, error) {
+	for _, variant := range []struct{ service, scheme string }{
+		{"etcd-client-ssl", "https"},
+		{"etcd-client", "http"},
+	} {
+		_, srvs, err := net.DefaultResolver.LookupSRV(ctx, variant.service, "tcp", domain)
+		if err != nil || len(srvs) == 0 {
+			continue
+		}
+
+		endpoints := make([]string, 0, len(srvs))
+		for _, srv := range srvs {
+			endpoints = append(endpoints,
+				fmt.Sprintf("%s://%s:%d", variant.scheme, strings.TrimSuffix(srv.Target, "."), srv.Port))
+		}
+		sort.Strings(endpoints)
+		return endpoints, nil
+	}
+
+	return nil, fmt.Errorf("no etcd client SRV records found for domain %s", domain)
+}
+
 // reload on-disk certificate and key when needed
 func getClientCertificateReloader(fpath string) (func(*tls.CertificateRequestInfo) (*tls.Certificate, error), error) {
 	yc := &yamlKeyPairConfig{}
@@ -1749,6 +2366,11 @@ func getClientCertificateReloader(fpath string) (func(*tls.CertificateRequestInf
 	}
 	reloader := func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
 		cer, err := tls.LoadX509KeyPair(yc.Certfile, yc.Keyfile)
+		if err == nil && len(cer.Certificate) > 0 {
+			if leaf, lerr := x509.ParseCertificate(cer.Certificate[0]); lerr == nil {
+				recordCertificateLifetime("client", leaf.NotAfter)
+			}
+		}
 		return &cer, err
 	}
 	return reloader, nil
@@ -1773,6 +2395,10 @@ type yamlConfig struct {
 	Keyfile               string `json:"key-file"`
 	TrustedCAfile         string `json:"trusted-ca-file"`
 
+	// DiscoverySrv is the domain used to discover the client endpoints
+	// through DNS SRV records, as an alternative to static endpoints.
+	DiscoverySrv string `json:"discovery-srv"`
+
 	// CAfile is being deprecated. Use 'TrustedCAfile' instead.
 	// TODO: deprecate this in v4
 	CAfile string `json:"ca-file"`
@@ -9,6 +9,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 	"net"
 	"net/url"
 	"os"
+	"path"
 	"regexp"
 	"strings"
 
@@ -51,6 +53,17 @@ func (DefaultEtcdDbgDialer) DialContext(ctx context.Context, addr string) (net.C
 // EtcdDbg performs a set of sanity checks concerning the connection to the given
 // etcd cluster, and outputs the result in a user-friendly format.
 func EtcdDbg(ctx context.Context, cfgfile string, dialer EtcdDbgDialer, w io.Writer) {
+	etcdDbg(ctx, cfgfile, "", dialer, w)
+}
+
+// EtcdDbgCluster performs the same sanity checks as EtcdDbg, additionally
+// verifying the retrieval of the configuration and of the synchronization
+// canaries advertised by the given remote cluster.
+func EtcdDbgCluster(ctx context.Context, cfgfile, cluster string, dialer EtcdDbgDialer, w io.Writer) {
+	etcdDbg(ctx, cfgfile, cluster, dialer, w)
+}
+
+func etcdDbg(ctx context.Context, cfgfile, cluster string, dialer EtcdDbgDialer, w io.Writer) {
 	iw := newIndentedWriter(w, 0)
 
 	iw.Println("📄 Configuration path: %s", cfgfile)
@@ -103,6 +116,62 @@ func EtcdDbg(ctx context.Context, cfgfile string, dialer EtcdDbgDialer, w io.Wri
 	if out.Header != nil {
 		iiw.Println("ℹ️  Etcd cluster ID: %x", out.Header.GetClusterId())
 	}
+
+	if cluster == "" {
+		return
+	}
+
+	iw.NewLine()
+	iw.Println("🌐 Remote cluster %q:", cluster)
+	etcdDbgClusterChecks(ctx, cl, cluster, iw.WithExtraIndent(3))
+}
+
+// etcdDbgClusterChecks verifies the retrieval of the configuration and of the
+// synchronization canaries advertised by the given remote cluster, to detect
+// mismatching cluster names and incomplete initial synchronizations.
+func etcdDbgClusterChecks(ctx context.Context, cl *client.Client, cluster string, iw *indentedWriter) {
+	out, err := cl.Get(ctx, path.Join(ClusterConfigPrefix, cluster))
+	switch {
+	case err != nil:
+		iw.Println("❌ Failed to retrieve cluster configuration: %s", err)
+	case len(out.Kvs) == 0:
+		iw.Println("❌ Cluster configuration not found (is the cluster name correct?)")
+	default:
+		var cfg struct {
+			ID           uint32 `json:"id"`
+			Capabilities struct {
+				SyncedCanaries bool `json:"syncedCanaries"`
+				Cached         bool `json:"cached"`
+			} `json:"capabilities"`
+		}
+
+		if err := json.Unmarshal(out.Kvs[0].Value, &cfg); err != nil {
+			iw.Println("❌ Failed to parse cluster configuration: %s", err)
+			break
+		}
+
+		iw.Println("✅ Cluster configuration successfully retrieved: cluster ID %d", cfg.ID)
+		if cfg.Capabilities.Cached {
+			iw.Println("ℹ️  Endpoints backed by KVStoreMesh")
+		}
+		if !cfg.Capabilities.SyncedCanaries {
+			iw.Println("ℹ️  Synchronization canaries not advertised")
+		}
+	}
+
+	prefix := path.Join(SyncedPrefix, cluster) + "/"
+	resp, err := cl.Get(ctx, prefix, client.WithPrefix(), client.WithKeysOnly())
+	switch {
+	case err != nil:
+		iw.Println("❌ Failed to retrieve synchronization canaries: %s", err)
+	case len(resp.Kvs) == 0:
+		iw.Println("⚠️ No synchronization canaries found: initial synchronization may not have completed yet")
+	default:
+		iw.Println("✅ Synchronization canaries:")
+		for _, kv := range resp.Kvs {
+			iw.WithExtraIndent(3).Println("- %s", strings.TrimPrefix(string(kv.Key), prefix))
+		}
+	}
 }
 
 func etcdDbgEndpoint(ctx context.Context, ep string, tlscfg *tls.Config, dialer EtcdDbgDialer, iw *indentedWriter) {
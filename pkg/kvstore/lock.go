@@ -39,6 +39,23 @@ type KVLocker interface {
 	Comparator() interface{}
 }
 
+// FencingToken is a monotonically increasing value identifying one given
+// acquisition of a lock. Writes performed under the protection of a lock can
+// be fenced with the corresponding token, so that the ones issued by an old
+// holder (e.g., one paused past its lease expiration) can be detected and
+// rejected, preventing split-brain updates.
+type FencingToken uint64
+
+// KVFencedLocker is implemented by the lockers able to associate a fencing
+// token with each acquisition of the lock.
+type KVFencedLocker interface {
+	KVLocker
+
+	// FencingToken returns the fencing token associated with the given
+	// acquisition of the lock.
+	FencingToken() FencingToken
+}
+
 // getLockPath returns the lock path representation of the given path.
 func getLockPath(path string) string {
 	return path + ".lock"
@@ -170,3 +187,12 @@ func (l *Lock) Unlock(ctx context.Context) error {
 func (l *Lock) Comparator() interface{} {
 	return l.kvLock.Comparator()
 }
+
+// FencingToken returns the fencing token associated with the underlying
+// kvstore lock, or zero if the backend does not support fencing.
+func (l *Lock) FencingToken() FencingToken {
+	if fl, ok := l.kvLock.(KVFencedLocker); ok {
+		return fl.FencingToken()
+	}
+	return 0
+}
@@ -45,6 +45,38 @@ func trackEventQueued(key string, typ EventType, duration time.Duration) {
 	metrics.KVStoreEventsQueueDuration.WithLabelValues(GetScopeFromKey(key), typ.String()).Observe(duration.Seconds())
 }
 
+func trackEventQueueDepth(key string, depth int) {
+	if !metrics.KVStoreEventsQueueDepth.IsEnabled() {
+		return
+	}
+	metrics.KVStoreEventsQueueDepth.WithLabelValues(GetScopeFromKey(key)).Observe(float64(depth))
+}
+
+func trackListPage(prefix string, err error) {
+	if !metrics.KVStoreInitialListPages.IsEnabled() {
+		return
+	}
+	metrics.KVStoreInitialListPages.WithLabelValues(GetScopeFromKey(prefix), metrics.Error2Outcome(err)).Inc()
+}
+
+func recordCertificateLifetime(typ string, notAfter time.Time) {
+	if !metrics.KVStoreCertificateLifetime.IsEnabled() {
+		return
+	}
+	metrics.KVStoreCertificateLifetime.WithLabelValues(typ).Set(time.Until(notAfter).Seconds())
+}
+
+func recordFailoverActive(active bool) {
+	if !metrics.KVStoreFailoverActive.IsEnabled() {
+		return
+	}
+	value := float64(0)
+	if active {
+		value = 1
+	}
+	metrics.KVStoreFailoverActive.Set(value)
+}
+
 func recordQuorumError(err string) {
 	if !metrics.KVStoreQuorumErrors.IsEnabled() {
 		return
@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"context"
+	"strings"
+)
+
+// NewNamespacedBackend wraps the given backend so that all keys are
+// transparently prefixed with the given namespace, allowing multiple Cilium
+// installations or meshes to safely share one etcd cluster without key
+// collisions. The namespace is stripped from the keys returned by read and
+// watch operations, making the wrapping invisible to the callers.
+func NewNamespacedBackend(backend BackendOperations, namespace string) BackendOperations {
+	return &namespacedBackend{
+		BackendOperations: backend,
+		prefix:            strings.TrimSuffix(namespace, "/") + "/",
+	}
+}
+
+type namespacedBackend struct {
+	BackendOperations
+
+	prefix string
+}
+
+// key returns the given key prefixed with the namespace.
+func (n *namespacedBackend) key(key string) string {
+	return n.prefix + key
+}
+
+// trim returns the given key with the namespace stripped.
+func (n *namespacedBackend) trim(key string) string {
+	return strings.TrimPrefix(key, n.prefix)
+}
+
+func (n *namespacedBackend) LockPath(ctx context.Context, path string) (KVLocker, error) {
+	return n.BackendOperations.LockPath(ctx, n.key(path))
+}
+
+func (n *namespacedBackend) Get(ctx context.Context, key string) ([]byte, error) {
+	return n.BackendOperations.Get(ctx, n.key(key))
+}
+
+func (n *namespacedBackend) GetIfLocked(ctx context.Context, key string, lock KVLocker) ([]byte, error) {
+	return n.BackendOperations.GetIfLocked(ctx, n.key(key), lock)
+}
+
+func (n *namespacedBackend) Delete(ctx context.Context, key string) error {
+	return n.BackendOperations.Delete(ctx, n.key(key))
+}
+
+func (n *namespacedBackend) DeleteIfLocked(ctx context.Context, key string, lock KVLocker) error {
+	return n.BackendOperations.DeleteIfLocked(ctx, n.key(key), lock)
+}
+
+func (n *namespacedBackend) DeletePrefix(ctx context.Context, path string) error {
+	return n.BackendOperations.DeletePrefix(ctx, n.key(path))
+}
+
+func (n *namespacedBackend) Update(ctx context.Context, key string, value []byte, lease bool) error {
+	return n.BackendOperations.Update(ctx, n.key(key), value, lease)
+}
+
+func (n *namespacedBackend) UpdateIfLocked(ctx context.Context, key string, value []byte, lease bool, lock KVLocker) error {
+	return n.BackendOperations.UpdateIfLocked(ctx, n.key(key), value, lease, lock)
+}
+
+func (n *namespacedBackend) UpdateIfDifferent(ctx context.Context, key string, value []byte, lease bool) (bool, error) {
+	return n.BackendOperations.UpdateIfDifferent(ctx, n.key(key), value, lease)
+}
+
+func (n *namespacedBackend) UpdateIfDifferentIfLocked(ctx context.Context, key string, value []byte, lease bool, lock KVLocker) (bool, error) {
+	return n.BackendOperations.UpdateIfDifferentIfLocked(ctx, n.key(key), value, lease, lock)
+}
+
+func (n *namespacedBackend) CreateOnly(ctx context.Context, key string, value []byte, lease bool) (bool, error) {
+	return n.BackendOperations.CreateOnly(ctx, n.key(key), value, lease)
+}
+
+func (n *namespacedBackend) CreateOnlyIfLocked(ctx context.Context, key string, value []byte, lease bool, lock KVLocker) (bool, error) {
+	return n.BackendOperations.CreateOnlyIfLocked(ctx, n.key(key), value, lease, lock)
+}
+
+func (n *namespacedBackend) Txn(ctx context.Context, conditions []TxnCondition, ops []TxnOp) (bool, error) {
+	nsConditions := make([]TxnCondition, 0, len(conditions))
+	for _, cnd := range conditions {
+		cnd.Key = n.key(cnd.Key)
+		nsConditions = append(nsConditions, cnd)
+	}
+
+	nsOps := make([]TxnOp, 0, len(ops))
+	for _, op := range ops {
+		op.Key = n.key(op.Key)
+		nsOps = append(nsOps, op)
+	}
+
+	return n.BackendOperations.Txn(ctx, nsConditions, nsOps)
+}
+
+func (n *namespacedBackend) ListPrefix(ctx context.Context, prefix string) (KeyValuePairs, error) {
+	pairs, err := n.BackendOperations.ListPrefix(ctx, n.key(prefix))
+	return n.trimPairs(pairs), err
+}
+
+func (n *namespacedBackend) ListPrefixIfLocked(ctx context.Context, prefix string, lock KVLocker) (KeyValuePairs, error) {
+	pairs, err := n.BackendOperations.ListPrefixIfLocked(ctx, n.key(prefix), lock)
+	return n.trimPairs(pairs), err
+}
+
+func (n *namespacedBackend) trimPairs(pairs KeyValuePairs) KeyValuePairs {
+	trimmed := make(KeyValuePairs, len(pairs))
+	for key, value := range pairs {
+		trimmed[n.trim(key)] = value
+	}
+	return trimmed
+}
+
+func (n *namespacedBackend) ListAndWatch(ctx context.Context, prefix string, chanSize int) *Watcher {
+	inner := n.BackendOperations.ListAndWatch(ctx, n.key(prefix), chanSize)
+	w := newWatcher(prefix, chanSize)
+
+	go func() {
+		defer close(w.Events)
+		defer w.stopWait.Done()
+
+		for {
+			select {
+			case event, ok := <-inner.Events:
+				if !ok {
+					return
+				}
+
+				event.Key = n.trim(event.Key)
+				select {
+				case w.Events <- event:
+				case <-w.stopWatch:
+					inner.Stop()
+					return
+				}
+			case <-w.stopWatch:
+				inner.Stop()
+				return
+			}
+		}
+	}()
+
+	return w
+}
+
+func (n *namespacedBackend) RegisterLeaseExpiredObserver(prefix string, fn func(key string)) {
+	if fn == nil {
+		n.BackendOperations.RegisterLeaseExpiredObserver(n.key(prefix), nil)
+		return
+	}
+
+	n.BackendOperations.RegisterLeaseExpiredObserver(n.key(prefix), func(key string) {
+		fn(n.trim(key))
+	})
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type namespaceFakeBackend struct {
+	BackendOperations
+
+	values map[string][]byte
+}
+
+func newNamespaceFakeBackend() *namespaceFakeBackend {
+	return &namespaceFakeBackend{values: make(map[string][]byte)}
+}
+
+func (f *namespaceFakeBackend) Get(_ context.Context, key string) ([]byte, error) {
+	return f.values[key], nil
+}
+
+func (f *namespaceFakeBackend) Update(_ context.Context, key string, value []byte, _ bool) error {
+	f.values[key] = value
+	return nil
+}
+
+func (f *namespaceFakeBackend) Delete(_ context.Context, key string) error {
+	delete(f.values, key)
+	return nil
+}
+
+func (f *namespaceFakeBackend) ListPrefix(_ context.Context, prefix string) (KeyValuePairs, error) {
+	pairs := make(KeyValuePairs)
+	for key, value := range f.values {
+		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
+			pairs[key] = Value{Data: value}
+		}
+	}
+	return pairs, nil
+}
+
+func (f *namespaceFakeBackend) ListAndWatch(ctx context.Context, prefix string, chanSize int) *Watcher {
+	events := make(chan KeyValueEvent, chanSize)
+	go func() {
+		defer close(events)
+		for key, value := range f.values {
+			events <- KeyValueEvent{Typ: EventTypeCreate, Key: key, Value: value}
+		}
+		events <- KeyValueEvent{Typ: EventTypeListDone}
+		<-ctx.Done()
+	}()
+	return &Watcher{Events: events}
+}
+
+func TestNamespacedBackend(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fake := newNamespaceFakeBackend()
+	namespaced := NewNamespacedBackend(fake, "tenant-1")
+
+	// Write operations shall transparently prefix the keys.
+	require.NoError(t, namespaced.Update(ctx, "cilium/state/foo", []byte("value"), false))
+	require.Contains(t, fake.values, "tenant-1/cilium/state/foo")
+
+	// Read operations shall transparently prefix and trim the keys.
+	value, err := namespaced.Get(ctx, "cilium/state/foo")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	pairs, err := namespaced.ListPrefix(ctx, "cilium/state/")
+	require.NoError(t, err)
+	require.Contains(t, pairs, "cilium/state/foo")
+
+	// Watch events shall be emitted with the namespace stripped.
+	watcher := namespaced.ListAndWatch(ctx, "cilium/state/", 16)
+	select {
+	case event := <-watcher.Events:
+		require.Equal(t, EventTypeCreate, event.Typ)
+		require.Equal(t, "cilium/state/foo", event.Key)
+	case <-time.After(time.Second):
+		t.Fatal("timeout while waiting for watcher event")
+	}
+
+	require.NoError(t, namespaced.Delete(ctx, "cilium/state/foo"))
+	require.NotContains(t, fake.values, "tenant-1/cilium/state/foo")
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"context"
+
+	"github.com/cilium/cilium/pkg/time"
+)
+
+type perCallCtxKey int
+
+const (
+	listLimitCtxKey perCallCtxKey = iota
+	opTimeoutCtxKey
+)
+
+// WithListLimit returns a context that overrides the maximum number of
+// entries retrieved in one batch by list operations performed with it,
+// taking precedence over the globally configured limit. This allows bulk
+// paths (e.g., the initial synchronization) to use a different budget than
+// interactive ones. A zero value equals to no limit.
+func WithListLimit(ctx context.Context, limit int) context.Context {
+	return context.WithValue(ctx, listLimitCtxKey, limit)
+}
+
+// listLimit returns the per-call list limit configured in the given context,
+// falling back to the provided default.
+func listLimit(ctx context.Context, dflt int) int {
+	if limit, ok := ctx.Value(listLimitCtxKey).(int); ok {
+		return limit
+	}
+	return dflt
+}
+
+// WithOperationTimeout returns a context that applies the given timeout to
+// each kvstore operation performed with it, rather than to the context as a
+// whole, so that latency-sensitive paths (e.g., status checks) can enforce a
+// stricter per-call budget than the global one.
+func WithOperationTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, opTimeoutCtxKey, timeout)
+}
+
+// operationTimeout returns a context expiring after the per-call timeout
+// configured in the given context, if any, along with the corresponding
+// cancel function.
+func operationTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if timeout, ok := ctx.Value(opTimeoutCtxKey).(time.Duration); ok && timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return ctx, func() {}
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/time"
+)
+
+func TestWithListLimit(t *testing.T) {
+	ctx := context.Background()
+
+	// The default shall be returned when no override is configured.
+	require.Equal(t, 100, listLimit(ctx, 100))
+
+	// The override shall take precedence over the default.
+	require.Equal(t, 25, listLimit(WithListLimit(ctx, 25), 100))
+
+	// A zero value equals to no limit.
+	require.Equal(t, 0, listLimit(WithListLimit(ctx, 0), 100))
+}
+
+func TestWithOperationTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	octx, cancel := operationTimeout(ctx)
+	defer cancel()
+	_, ok := octx.Deadline()
+	require.False(t, ok, "No deadline shall be set when no timeout is configured")
+
+	octx, cancel = operationTimeout(WithOperationTimeout(ctx, time.Minute))
+	defer cancel()
+	deadline, ok := octx.Deadline()
+	require.True(t, ok, "A deadline shall be set when a timeout is configured")
+	require.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+}
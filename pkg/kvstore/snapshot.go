@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package kvstore
+
+import (
+	"context"
+
+	client "go.etcd.io/etcd/client/v3"
+
+	"github.com/cilium/cilium/pkg/time"
+)
+
+// SnapshotEntry is a single key/value pair contained in a snapshot.
+type SnapshotEntry struct {
+	Key         string `json:"key"`
+	Value       []byte `json:"value"`
+	ModRevision uint64 `json:"modRevision"`
+}
+
+// Snapshot is a point-in-time export of the keys matching a set of prefixes,
+// retrieved at a single revision. It can be attached to bug reports to debug
+// state divergence between clusters.
+type Snapshot struct {
+	// Revision is the kvstore revision all entries were retrieved at.
+	Revision int64 `json:"revision"`
+
+	// Time is the local time the snapshot was started at.
+	Time time.Time `json:"time"`
+
+	// Entries are the key/value pairs contained in the snapshot.
+	Entries []SnapshotEntry `json:"entries"`
+}
+
+// BackendSnapshotter is implemented by the backends supporting the export of
+// a consistent point-in-time snapshot of a set of prefixes.
+type BackendSnapshotter interface {
+	// ExportSnapshot retrieves all keys matching the given prefixes at a
+	// single revision.
+	ExportSnapshot(ctx context.Context, prefixes ...string) (*Snapshot, error)
+}
+
+// ExportSnapshot retrieves all keys matching the given prefixes at a single
+// revision, which is determined by the first received response and pinned for
+// all subsequent paginated reads.
+func (e *etcdClient) ExportSnapshot(ctx context.Context, prefixes ...string) (*Snapshot, error) {
+	snapshot := &Snapshot{Time: time.Now()}
+
+	for _, prefix := range prefixes {
+		start, end := prefix, client.GetPrefixRangeEnd(prefix)
+
+		for {
+			lr, err := e.limiter.Wait(ctx)
+			if err != nil {
+				return nil, Hint(err)
+			}
+
+			res, err := e.client.Get(ctx, start, client.WithRange(end),
+				client.WithSort(client.SortByKey, client.SortAscend),
+				client.WithRev(snapshot.Revision), client.WithSerializable(),
+				client.WithLimit(int64(e.listBatchSize)),
+			)
+			if err != nil {
+				lr.Error(err, -1)
+				return nil, Hint(err)
+			}
+			lr.Done()
+
+			if snapshot.Revision == 0 {
+				snapshot.Revision = res.Header.Revision
+			}
+
+			for _, kv := range res.Kvs {
+				snapshot.Entries = append(snapshot.Entries, SnapshotEntry{
+					Key:         string(kv.Key),
+					Value:       kv.Value,
+					ModRevision: uint64(kv.ModRevision),
+				})
+			}
+
+			if !res.More || len(res.Kvs) == 0 {
+				break
+			}
+
+			start = string(res.Kvs[len(res.Kvs)-1].Key) + "\x00"
+		}
+	}
+
+	return snapshot, nil
+}
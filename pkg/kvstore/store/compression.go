@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package store
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+)
+
+// compressionMagic prefixes compressed values stored in the kvstore, to
+// distinguish them from plain ones. The leading zero byte cannot occur as
+// the first character of the JSON and label representations marshaled by
+// the key types, hence making the detection unambiguous.
+var compressionMagic = []byte{0x00, 'c', 'z', '1'}
+
+// CompressValue returns the zlib-compressed representation of the given
+// value, prepended with a magic header to enable transparent detection
+// on the read path.
+func CompressValue(value []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.Write(compressionMagic)
+	writer := zlib.NewWriter(&buf)
+	if _, err := writer.Write(value); err != nil {
+		return nil, fmt.Errorf("failed compressing value: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed compressing value: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// DecompressValue reverts the transformation performed by CompressValue.
+// Values not carrying the magic header are returned unchanged, to
+// transparently support sources not performing compression.
+func DecompressValue(value []byte) ([]byte, error) {
+	if !bytes.HasPrefix(value, compressionMagic) {
+		return value, nil
+	}
+
+	reader, err := zlib.NewReader(bytes.NewReader(value[len(compressionMagic):]))
+	if err != nil {
+		return nil, fmt.Errorf("failed decompressing value: %w", err)
+	}
+	defer reader.Close()
+
+	decompressed, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed decompressing value: %w", err)
+	}
+
+	return decompressed, nil
+}
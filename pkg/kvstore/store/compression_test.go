@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressValueRoundTrip(t *testing.T) {
+	value := []byte(`{"name":"qux","cluster":"foo","ip":"10.1.2.3"}`)
+
+	compressed, err := CompressValue(value)
+	require.NoError(t, err)
+	require.NotEqual(t, value, compressed)
+
+	decompressed, err := DecompressValue(compressed)
+	require.NoError(t, err)
+	require.Equal(t, value, decompressed)
+}
+
+func TestDecompressValuePassthrough(t *testing.T) {
+	// Values not carrying the magic header shall be returned unchanged.
+	value := []byte(`k8s:foo=bar;`)
+	decompressed, err := DecompressValue(value)
+	require.NoError(t, err)
+	require.Equal(t, value, decompressed)
+}
+
+func TestDecompressValueCorrupted(t *testing.T) {
+	_, err := DecompressValue(append(compressionMagic, []byte("not-compressed")...))
+	require.Error(t, err)
+}
@@ -12,6 +12,8 @@ type Metrics struct {
 	KVStoreSyncQueueSize        metric.Vec[metric.Gauge]
 	KVStoreSyncErrors           metric.Vec[metric.Counter]
 	KVStoreInitialSyncCompleted metric.Vec[metric.Gauge]
+	KVStoreLastSyncTimestamp    metric.Vec[metric.Gauge]
+	KVStoreLastEventTimestamp   metric.Vec[metric.Gauge]
 }
 
 func MetricsProvider() *Metrics {
@@ -34,5 +36,17 @@ func MetricsProvider() *Metrics {
 			Name:      "initial_sync_completed",
 			Help:      "Whether the initial synchronization from/to the kvstore has completed",
 		}, []string{metrics.LabelScope, metrics.LabelSourceCluster, metrics.LabelAction}),
+		KVStoreLastSyncTimestamp: metric.NewGaugeVec(metric.GaugeOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: metrics.SubsystemKVStore,
+			Name:      "last_sync_ts",
+			Help:      "The timestamp of the last successfully completed initial synchronization from the kvstore",
+		}, []string{metrics.LabelScope, metrics.LabelSourceCluster, metrics.LabelAction}),
+		KVStoreLastEventTimestamp: metric.NewGaugeVec(metric.GaugeOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: metrics.SubsystemKVStore,
+			Name:      "last_event_received_ts",
+			Help:      "The timestamp of the last watch event received from the kvstore",
+		}, []string{metrics.LabelScope, metrics.LabelSourceCluster, metrics.LabelAction}),
 	}
 }
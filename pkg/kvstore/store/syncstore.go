@@ -62,8 +62,9 @@ type wqSyncStore struct {
 	prefix  string
 	source  string
 
-	workers   uint
-	withLease bool
+	workers        uint
+	withLease      bool
+	compressValues bool
 
 	limiter   workqueue.RateLimiter
 	workqueue workqueue.RateLimitingInterface
@@ -113,6 +114,16 @@ func WSSWithSyncedKeyOverride(key string) WSSOpt {
 	}
 }
 
+// WSSWithCompressedValues enables the compression of upserted values, to reduce
+// the bandwidth required to propagate high-volume prefixes to remote clusters.
+// Readers must support transparent decompression, which shall be advertised
+// through the corresponding cluster configuration capability.
+func WSSWithCompressedValues() WSSOpt {
+	return func(wss *wqSyncStore) {
+		wss.compressValues = true
+	}
+}
+
 // NewWorkqueueSyncStore returns a SyncStore instance which leverages a workqueue
 // to coalescence update/delete requests and handle retries in case of errors.
 func newWorkqueueSyncStore(clusterName string, backend SyncStoreBackend, prefix string, m *Metrics, opts ...WSSOpt) SyncStore {
@@ -182,6 +193,12 @@ func (wss *wqSyncStore) UpsertKey(_ context.Context, k Key) error {
 		return fmt.Errorf("failed marshaling key %q: %w", k, err)
 	}
 
+	if wss.compressValues {
+		if value, err = CompressValue(value); err != nil {
+			return fmt.Errorf("failed compressing key %q: %w", k, err)
+		}
+	}
+
 	prevValue, loaded := wss.state.Swap(key, value)
 	if loaded && bytes.Equal(prevValue, value) {
 		wss.log.WithField(logfields.Key, k).Debug("ignoring upsert request for already up-to-date key")
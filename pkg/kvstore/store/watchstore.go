@@ -15,6 +15,7 @@ import (
 	"github.com/cilium/cilium/pkg/logging/logfields"
 	"github.com/cilium/cilium/pkg/metrics"
 	"github.com/cilium/cilium/pkg/metrics/metric"
+	"github.com/cilium/cilium/pkg/time"
 )
 
 // WatchStore abstracts the operations allowing to synchronize key/value pairs
@@ -31,6 +32,10 @@ type WatchStore interface {
 	// the kvstore, and new events are currently being watched.
 	Synced() bool
 
+	// SyncedAt returns the time at which the initial list of entries was last
+	// retrieved from the kvstore, or the zero time if it never completed.
+	SyncedAt() time.Time
+
 	// Drain emits a deletion event for each known key. It shall be called only
 	// when no watch operation is in progress.
 	Drain()
@@ -62,6 +67,17 @@ func RWSWithEntriesMetric(gauge prometheus.Gauge) RWSOpt {
 	}
 }
 
+// RWSWithEventsCoalescing accumulates the events received from the kvstore
+// for the given window before processing them, so that bursts of updates for
+// the same key (e.g., from a resyncing remote cluster) result in a single
+// downstream event. The relative order of events targeting different keys is
+// not preserved within a window. Zero disables coalescing.
+func RWSWithEventsCoalescing(window time.Duration) RWSOpt {
+	return func(rws *restartableWatchStore) {
+		rws.coalesceWindow = window
+	}
+}
+
 type rwsEntry struct {
 	key   Key
 	stale bool
@@ -79,6 +95,7 @@ type restartableWatchStore struct {
 
 	watching        atomic.Bool
 	synced          atomic.Bool
+	syncedAt        atomic.Int64
 	onSyncCallbacks []func(ctx context.Context)
 
 	// Using a separate entries counter avoids the need for synchronizing the
@@ -87,9 +104,15 @@ type restartableWatchStore struct {
 	state      map[string]*rwsEntry
 	numEntries atomic.Uint64
 
-	log           *logrus.Entry
-	entriesMetric prometheus.Gauge
-	syncMetric    metric.Vec[metric.Gauge]
+	// coalesceWindow is the window during which the received events are
+	// accumulated before being processed. Zero disables coalescing.
+	coalesceWindow time.Duration
+
+	log             *logrus.Entry
+	entriesMetric   prometheus.Gauge
+	syncMetric      metric.Vec[metric.Gauge]
+	lastSyncMetric  metric.Vec[metric.Gauge]
+	lastEventMetric metric.Vec[metric.Gauge]
 }
 
 // NewRestartableWatchStore returns a WatchStore instance which supports
@@ -104,9 +127,11 @@ func newRestartableWatchStore(clusterName string, keyCreator KeyCreator, observe
 
 		state: make(map[string]*rwsEntry),
 
-		log:           log,
-		entriesMetric: metrics.NoOpGauge,
-		syncMetric:    m.KVStoreInitialSyncCompleted,
+		log:             log,
+		entriesMetric:   metrics.NoOpGauge,
+		syncMetric:      m.KVStoreInitialSyncCompleted,
+		lastSyncMetric:  m.KVStoreLastSyncTimestamp,
+		lastEventMetric: m.KVStoreLastEventTimestamp,
 	}
 
 	for _, opt := range opts {
@@ -131,6 +156,10 @@ func (rws *restartableWatchStore) Watch(ctx context.Context, backend WatchStoreB
 	rws.log = rws.log.WithField(logfields.Prefix, prefix)
 	syncedMetric := rws.syncMetric.WithLabelValues(
 		kvstore.GetScopeFromKey(prefix), rws.source, "read")
+	lastSyncMetric := rws.lastSyncMetric.WithLabelValues(
+		kvstore.GetScopeFromKey(prefix), rws.source, "read")
+	lastEventMetric := rws.lastEventMetric.WithLabelValues(
+		kvstore.GetScopeFromKey(prefix), rws.source, "read")
 
 	rws.log.Info("Starting restartable watch store")
 	syncedMetric.Set(metrics.BoolToFloat64(false))
@@ -151,41 +180,85 @@ func (rws *restartableWatchStore) Watch(ctx context.Context, backend WatchStoreB
 		entry.stale = true
 	}
 
+	// pending accumulates the events to be processed when coalescing is
+	// enabled, keeping the last received event for each key only.
+	var (
+		pending map[string]kvstore.KeyValueEvent
+		flushC  <-chan time.Time
+	)
+
+	flush := func() {
+		for key, event := range pending {
+			rws.processEvent(event.Typ, key, event.Value)
+		}
+		pending = nil
+		flushC = nil
+	}
+
 	// The events channel is closed when the context is closed.
 	watcher := backend.ListAndWatch(ctx, prefix, 0)
-	for event := range watcher.Events {
-		if event.Typ == kvstore.EventTypeListDone {
-			rws.log.Debug("Initial synchronization completed")
-			rws.drainKeys(true)
-			syncedMetric.Set(metrics.BoolToFloat64(true))
-			rws.synced.Store(true)
-
-			for _, callback := range rws.onSyncCallbacks {
-				callback(ctx)
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
 			}
 
-			// Clear the list of callbacks so that they don't get executed
-			// a second time in case of reconnections.
-			rws.onSyncCallbacks = nil
+			if event.Typ == kvstore.EventTypeListDone {
+				// Process the accumulated events before draining stale keys.
+				flush()
 
-			continue
-		}
+				rws.log.Debug("Initial synchronization completed")
+				rws.drainKeys(true)
+				syncedMetric.Set(metrics.BoolToFloat64(true))
+				lastSyncMetric.SetToCurrentTime()
+				rws.syncedAt.Store(time.Now().UnixNano())
+				rws.synced.Store(true)
 
-		key := strings.TrimPrefix(event.Key, prefix)
-		rws.log.WithFields(logrus.Fields{
-			logfields.Key:   key,
-			logfields.Event: event.Typ,
-		}).Debug("Received event from kvstore")
+				for _, callback := range rws.onSyncCallbacks {
+					callback(ctx)
+				}
 
-		switch event.Typ {
-		case kvstore.EventTypeCreate, kvstore.EventTypeModify:
-			rws.handleUpsert(key, event.Value)
-		case kvstore.EventTypeDelete:
-			rws.handleDelete(key)
+				// Clear the list of callbacks so that they don't get executed
+				// a second time in case of reconnections.
+				rws.onSyncCallbacks = nil
+
+				continue
+			}
+
+			key := strings.TrimPrefix(event.Key, prefix)
+			rws.log.WithFields(logrus.Fields{
+				logfields.Key:   key,
+				logfields.Event: event.Typ,
+			}).Debug("Received event from kvstore")
+			lastEventMetric.SetToCurrentTime()
+
+			if rws.coalesceWindow == 0 {
+				rws.processEvent(event.Typ, key, event.Value)
+				continue
+			}
+
+			if pending == nil {
+				pending = make(map[string]kvstore.KeyValueEvent)
+				flushC = time.After(rws.coalesceWindow)
+			}
+			pending[key] = event
+
+		case <-flushC:
+			flush()
 		}
 	}
 }
 
+func (rws *restartableWatchStore) processEvent(typ kvstore.EventType, key string, value []byte) {
+	switch typ {
+	case kvstore.EventTypeCreate, kvstore.EventTypeModify:
+		rws.handleUpsert(key, value)
+	case kvstore.EventTypeDelete:
+		rws.handleDelete(key)
+	}
+}
+
 // NumEntries returns the number of entries synchronized from the store.
 func (rws *restartableWatchStore) NumEntries() uint64 {
 	return rws.numEntries.Load()
@@ -197,6 +270,15 @@ func (rws *restartableWatchStore) Synced() bool {
 	return rws.synced.Load()
 }
 
+// SyncedAt returns the time at which the initial list of entries was last
+// retrieved from the kvstore, or the zero time if it never completed.
+func (rws *restartableWatchStore) SyncedAt() time.Time {
+	if ns := rws.syncedAt.Load(); ns != 0 {
+		return time.Unix(0, ns)
+	}
+	return time.Time{}
+}
+
 // Drain emits a deletion event for each known key. It shall be called only
 // when no watch operation is in progress.
 func (rws *restartableWatchStore) Drain() {
@@ -223,6 +305,13 @@ func (rws *restartableWatchStore) drainKeys(staleOnly bool) {
 }
 
 func (rws *restartableWatchStore) handleUpsert(key string, value []byte) {
+	value, err := DecompressValue(value)
+	if err != nil {
+		rws.log.WithField(logfields.Key, key).
+			WithError(err).Warning("Unable to decompress value")
+		return
+	}
+
 	entry := &rwsEntry{key: rws.keyCreator()}
 	if err := entry.key.Unmarshal(key, value); err != nil {
 		rws.log.WithFields(logrus.Fields{
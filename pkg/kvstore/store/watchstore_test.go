@@ -249,6 +249,39 @@ func TestRestartableWatchStoreSyncCallback(t *testing.T) {
 	}))
 }
 
+func TestRestartableWatchStoreEventsCoalescing(t *testing.T) {
+	observer := NewFakeObserver(t)
+	f, _ := GetFactory(t)
+	store := f.NewWatchStore("qux", KVPairCreator, observer,
+		RWSWithEventsCoalescing(100*time.Millisecond))
+
+	rwsRun(store, "foo/bar", func() {
+		// The events received before the initial list completion are flushed
+		// when the list done event is received, emitting the last event only
+		// for each key. There's no ordering guarantee across different keys.
+		require.ElementsMatch(t,
+			[]*KVPair{NewKVPair("key1", "value1B"), NewKVPair("key2", "value2A")},
+			[]*KVPair{eventually(observer.updated), eventually(observer.updated)})
+		require.Eventually(t, store.Synced, timeout, tick, "The store should now be synced")
+
+		// The subsequent events are flushed when the coalescing window expires,
+		// with the deletion of key2 being superseded by its recreation.
+		require.ElementsMatch(t,
+			[]*KVPair{NewKVPair("key1", "value1D"), NewKVPair("key2", "value2B")},
+			[]*KVPair{eventually(observer.updated), eventually(observer.updated)})
+		require.Equal(t, uint64(2), store.NumEntries())
+	}, NewFakeLWBackend(t, "foo/bar/", []kvstore.KeyValueEvent{
+		{Typ: kvstore.EventTypeCreate, Key: "key1", Value: []byte("value1A")},
+		{Typ: kvstore.EventTypeModify, Key: "key1", Value: []byte("value1B")},
+		{Typ: kvstore.EventTypeCreate, Key: "key2", Value: []byte("value2A")},
+		{Typ: kvstore.EventTypeListDone},
+		{Typ: kvstore.EventTypeModify, Key: "key1", Value: []byte("value1C")},
+		{Typ: kvstore.EventTypeModify, Key: "key1", Value: []byte("value1D")},
+		{Typ: kvstore.EventTypeDelete, Key: "key2"},
+		{Typ: kvstore.EventTypeCreate, Key: "key2", Value: []byte("value2B")},
+	}))
+}
+
 func TestRestartableWatchStoreConcurrent(t *testing.T) {
 	var wg sync.WaitGroup
 	ctx, cancel := context.WithCancel(context.Background())
@@ -285,12 +318,16 @@ func TestRestartableWatchStoreMetrics(t *testing.T) {
 
 	entries := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_elements_metric"})
 	synced := m.KVStoreInitialSyncCompleted.WithLabelValues("nodes/v1", "qux", "read")
+	lastSync := m.KVStoreLastSyncTimestamp.WithLabelValues("nodes/v1", "qux", "read")
+	lastEvent := m.KVStoreLastEventTimestamp.WithLabelValues("nodes/v1", "qux", "read")
 
 	observer := NewFakeObserver(t)
 	store := f.NewWatchStore("qux", KVPairCreator, observer, RWSWithEntriesMetric(entries))
 
 	require.Equal(t, float64(0), testutil.ToFloat64(entries))
 	require.Equal(t, metrics.BoolToFloat64(false), testutil.ToFloat64(synced))
+	require.Zero(t, testutil.ToFloat64(lastSync))
+	require.Zero(t, testutil.ToFloat64(lastEvent))
 
 	rwsRun(store, "cilium/state/nodes/v1", func() {
 		require.Equal(t, NewKVPair("key1", "value1A"), eventually(observer.updated))
@@ -316,6 +353,8 @@ func TestRestartableWatchStoreMetrics(t *testing.T) {
 	// The metric should reflect the number of elements.
 	require.Equal(t, float64(2), testutil.ToFloat64(entries))
 	require.Equal(t, metrics.BoolToFloat64(false), testutil.ToFloat64(synced))
+	require.NotZero(t, testutil.ToFloat64(lastSync))
+	require.NotZero(t, testutil.ToFloat64(lastEvent))
 
 	rwsRun(store, "cilium/state/nodes/v1", func() {
 		require.Equal(t, NewKVPair("key3", "value3A"), eventually(observer.updated))
@@ -401,6 +401,7 @@ type SVC struct {
 	NatPolicy                 SVCNatPolicy     // Service NAT 46/64 policy
 	SessionAffinity           bool
 	SessionAffinityTimeoutSec uint32
+	PassiveHealth             bool        // Passive backend health scoring enabled
 	HealthCheckNodePort       uint16      // Service health check node port
 	Name                      ServiceName // Fully qualified service name
 	LoadBalancerSourceRanges  []*cidr.CIDR
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package ctmap
+
+import (
+	"errors"
+	"os"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/maps/timestamp"
+)
+
+const (
+	// backendConnectGracePeriod is the number of seconds a TCP service entry
+	// which has not yet seen a reply is given before it is accounted as a
+	// failed connection attempt, to avoid counting in-flight handshakes.
+	backendConnectGracePeriod = 5
+
+	// backendStaleReplyTimeout is the number of seconds the last reply of an
+	// established TCP service entry may lag behind the last request before
+	// the backend is accounted as unresponsive for this connection.
+	backendStaleReplyTimeout = 10
+)
+
+// BackendTCPStats aggregates the state of the TCP conntrack entries which
+// were load-balanced to a given backend.
+type BackendTCPStats struct {
+	// Established is the number of entries which completed the TCP
+	// handshake.
+	Established uint64
+
+	// FailedConnects is the number of entries which never saw a reply from
+	// the backend.
+	FailedConnects uint64
+
+	// StaleReplies is the number of established entries whose last reply
+	// lags significantly behind the last request, indicating an unresponsive
+	// or slow backend.
+	StaleReplies uint64
+}
+
+// ScanTCPBackendStats dumps the global TCP conntrack maps and aggregates the
+// state of all service entries per backend ID. It is used for passive backend
+// health scoring and only accounts entries created by the service load
+// balancer.
+func ScanTCPBackendStats(ipv4, ipv6 bool) (map[uint64]*BackendTCPStats, error) {
+	clockSource := timestamp.GetClockSourceFromOptions()
+	now, err := timestamp.GetCTCurTime(clockSource)
+	if err != nil {
+		return nil, err
+	}
+
+	// The report timestamps are in clock-source units (scaled jiffies when
+	// the timing mode is jiffies), so the thresholds in seconds must undergo
+	// the same sec to mono conversion the datapath applies.
+	secToCTTime, err := timestamp.NewSecToCTTimeConverter(clockSource)
+	if err != nil {
+		return nil, err
+	}
+	connectGracePeriod := secToCTTime(backendConnectGracePeriod)
+	staleReplyTimeout := secToCTTime(backendStaleReplyTimeout)
+
+	stats := make(map[uint64]*BackendTCPStats)
+	cb := func(_ bpf.MapKey, v bpf.MapValue) {
+		entry := v.(*CtEntry)
+		if entry.BackendID == 0 {
+			return
+		}
+
+		s, ok := stats[entry.BackendID]
+		if !ok {
+			s = &BackendTCPStats{}
+			stats[entry.BackendID] = s
+		}
+
+		switch {
+		case entry.RxFlagsSeen != 0:
+			s.Established++
+			if entry.LastTxReport > entry.LastRxReport &&
+				uint64(entry.LastTxReport-entry.LastRxReport) >= staleReplyTimeout {
+				s.StaleReplies++
+			}
+		case uint64(entry.LastTxReport)+connectGracePeriod < now:
+			s.FailedConnects++
+		}
+	}
+
+	for _, m := range GlobalMaps(ipv4, ipv6) {
+		if !m.mapType.isTCP() {
+			continue
+		}
+		err := m.Open()
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		err = m.DumpWithCallback(cb)
+		m.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stats, nil
+}
@@ -50,7 +50,7 @@ func initAffinity(params InitParams) {
 			&AffinityValue{},
 			AffinityMapMaxEntries,
 			0,
-		)
+		).WithPressureMetric()
 	}
 
 	if params.IPv6 {
@@ -61,7 +61,7 @@ func initAffinity(params InitParams) {
 			&AffinityValue{},
 			AffinityMapMaxEntries,
 			0,
-		)
+		).WithPressureMetric()
 	}
 }
 
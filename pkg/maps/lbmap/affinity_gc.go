@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package lbmap
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/byteorder"
+	"github.com/cilium/cilium/pkg/maps/timestamp"
+)
+
+// PruneSessionAffinity removes affinity entries whose lastUsed timestamp
+// exceeds the session affinity timeout of the service they belong to, given
+// as a mapping from reverse NAT index to timeout in seconds. Entries
+// referring to no longer existing services are removed as well. It returns
+// the number of entries removed.
+//
+// The datapath only refreshes or drops an expired entry when the client
+// sends further traffic, so entries of idle clients would otherwise linger
+// until evicted by the LRU logic of the map.
+func (*LBBPFMap) PruneSessionAffinity(timeouts map[uint16]uint32) (int, error) {
+	clockSource := timestamp.GetClockSourceFromOptions()
+	now, err := timestamp.GetCTCurTime(clockSource)
+	if err != nil {
+		return 0, fmt.Errorf("retrieving current datapath time: %w", err)
+	}
+
+	// The timestamps are in clock-source units (scaled jiffies when the
+	// timing mode is jiffies), so the timeouts in seconds must undergo the
+	// same sec to mono conversion the datapath applies.
+	secToCTTime, err := timestamp.NewSecToCTTimeConverter(clockSource)
+	if err != nil {
+		return 0, fmt.Errorf("creating timeout converter: %w", err)
+	}
+
+	pruned := 0
+	var errs error
+
+	gc := func(m *bpf.Map) {
+		if m == nil || !m.IsOpen() {
+			return
+		}
+
+		toDelete := []bpf.MapKey{}
+		err := m.DumpWithCallback(func(key bpf.MapKey, value bpf.MapValue) {
+			v := value.(*AffinityValue)
+			timeout, ok := timeouts[affinityKeyRevNatID(key)]
+			if !ok || v.LastUsed+secToCTTime(uint64(timeout)) < now {
+				toDelete = append(toDelete, key)
+			}
+		})
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("dumping %s: %w", m.Name(), err))
+		}
+		for _, key := range toDelete {
+			if deleted, _ := m.SilentDelete(key); deleted {
+				pruned++
+			}
+		}
+	}
+
+	gc(Affinity4Map)
+	gc(Affinity6Map)
+
+	return pruned, errs
+}
+
+// affinityKeyRevNatID returns the reverse NAT index of the given affinity
+// map key in host byte order. The index is stored in network byte order,
+// consistently with the services map it is derived from.
+func affinityKeyRevNatID(key bpf.MapKey) uint16 {
+	switch k := key.(type) {
+	case *Affinity4Key:
+		return byteorder.NetworkToHost16(k.RevNATID)
+	case *Affinity6Key:
+		return byteorder.NetworkToHost16(k.RevNATID)
+	}
+	return 0
+}
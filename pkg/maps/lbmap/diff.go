@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package lbmap
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/loadbalancer"
+)
+
+// StateDiffEntry describes a single discrepancy between the desired
+// load-balancing state and the content of the BPF maps.
+type StateDiffEntry struct {
+	Frontend string   `json:"frontend"`
+	Desired  []string `json:"desired,omitempty"`
+	Actual   []string `json:"actual,omitempty"`
+}
+
+// StateDiff aggregates the discrepancies between the desired load-balancing
+// state and the content of the BPF maps.
+type StateDiff struct {
+	// Missing contains the frontends present in the desired state but
+	// absent from the BPF maps.
+	Missing []StateDiffEntry `json:"missing"`
+	// Extra contains the frontends present in the BPF maps but absent
+	// from the desired state.
+	Extra []StateDiffEntry `json:"extra"`
+	// Divergent contains the frontends present on both sides, but whose
+	// backends differ.
+	Divergent []StateDiffEntry `json:"divergent"`
+}
+
+// Empty returns whether the diff contains no discrepancy.
+func (d *StateDiff) Empty() bool {
+	return len(d.Missing) == 0 && len(d.Extra) == 0 && len(d.Divergent) == 0
+}
+
+// DumpFrontendsWithBackends dumps the service and backend maps, returning
+// the mapping from each frontend address to the sorted list of addresses
+// of the associated backends.
+func DumpFrontendsWithBackends() (map[string][]string, error) {
+	// It's safe to use the same map for both IPv4 and IPv6, as backend
+	// IDs are allocated from the same pool regardless the protocol
+	backends := make(map[loadbalancer.BackendID]string)
+
+	parseBackendEntry := func(key bpf.MapKey, value bpf.MapValue) {
+		id := key.(BackendKey).GetID()
+		val := value.(BackendValue).ToHost()
+		addr := val.GetAddress().String()
+		port := strconv.Itoa(int(val.GetPort()))
+		backends[id] = net.JoinHostPort(addr, port)
+	}
+	if err := Backend4MapV3.DumpWithCallbackIfExists(parseBackendEntry); err != nil {
+		return nil, fmt.Errorf("unable to dump IPv4 backends table: %w", err)
+	}
+	if err := Backend6MapV3.DumpWithCallbackIfExists(parseBackendEntry); err != nil {
+		return nil, fmt.Errorf("unable to dump IPv6 backends table: %w", err)
+	}
+
+	frontends := make(map[string][]string)
+	parseSVCEntry := func(key bpf.MapKey, value bpf.MapValue) {
+		svcKey := key.(ServiceKey).ToHost()
+		svcVal := value.(ServiceValue).ToHost()
+
+		addr := svcKey.GetAddress().String()
+		port := strconv.Itoa(int(svcKey.GetPort()))
+		frontend := net.JoinHostPort(addr, port)
+
+		if svcKey.GetBackendSlot() == 0 {
+			// The slot zero entry defines the frontend itself, possibly
+			// with no backends associated.
+			if _, ok := frontends[frontend]; !ok {
+				frontends[frontend] = []string{}
+			}
+			return
+		}
+
+		backend, ok := backends[svcVal.GetBackendID()]
+		if !ok {
+			backend = fmt.Sprintf("backend %d not found", svcVal.GetBackendID())
+		}
+		frontends[frontend] = append(frontends[frontend], backend)
+	}
+	if err := Service4MapV2.DumpWithCallbackIfExists(parseSVCEntry); err != nil {
+		return nil, fmt.Errorf("unable to dump IPv4 services table: %w", err)
+	}
+	if err := Service6MapV2.DumpWithCallbackIfExists(parseSVCEntry); err != nil {
+		return nil, fmt.Errorf("unable to dump IPv6 services table: %w", err)
+	}
+
+	for _, bes := range frontends {
+		sort.Strings(bes)
+	}
+	return frontends, nil
+}
+
+// DiffState compares the given desired and actual mappings from frontend
+// address to backend addresses, reporting missing, extra and divergent
+// entries. The backend lists are expected to be sorted.
+func DiffState(desired, actual map[string][]string) *StateDiff {
+	diff := &StateDiff{
+		Missing:   []StateDiffEntry{},
+		Extra:     []StateDiffEntry{},
+		Divergent: []StateDiffEntry{},
+	}
+
+	for frontend, want := range desired {
+		got, ok := actual[frontend]
+		if !ok {
+			diff.Missing = append(diff.Missing, StateDiffEntry{Frontend: frontend, Desired: want})
+			continue
+		}
+		if !equalBackends(want, got) {
+			diff.Divergent = append(diff.Divergent, StateDiffEntry{Frontend: frontend, Desired: want, Actual: got})
+		}
+	}
+
+	for frontend, got := range actual {
+		if _, ok := desired[frontend]; !ok {
+			diff.Extra = append(diff.Extra, StateDiffEntry{Frontend: frontend, Actual: got})
+		}
+	}
+
+	sortEntries := func(entries []StateDiffEntry) {
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Frontend < entries[j].Frontend })
+	}
+	sortEntries(diff.Missing)
+	sortEntries(diff.Extra)
+	sortEntries(diff.Divergent)
+	return diff
+}
+
+func equalBackends(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package lbmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffState(t *testing.T) {
+	desired := map[string][]string{
+		"10.0.0.1:80":  {"192.168.1.1:8080", "192.168.1.2:8080"},
+		"10.0.0.2:443": {"192.168.1.3:8443"},
+		"10.0.0.3:80":  {},
+	}
+	actual := map[string][]string{
+		"10.0.0.1:80": {"192.168.1.1:8080"},
+		"10.0.0.3:80": {},
+		"10.0.0.4:80": {"192.168.1.4:8080"},
+	}
+
+	diff := DiffState(desired, actual)
+	require.False(t, diff.Empty())
+
+	require.Len(t, diff.Missing, 1)
+	require.Equal(t, "10.0.0.2:443", diff.Missing[0].Frontend)
+
+	require.Len(t, diff.Extra, 1)
+	require.Equal(t, "10.0.0.4:80", diff.Extra[0].Frontend)
+
+	require.Len(t, diff.Divergent, 1)
+	require.Equal(t, "10.0.0.1:80", diff.Divergent[0].Frontend)
+	require.Equal(t, []string{"192.168.1.1:8080", "192.168.1.2:8080"}, diff.Divergent[0].Desired)
+	require.Equal(t, []string{"192.168.1.1:8080"}, diff.Divergent[0].Actual)
+
+	require.True(t, DiffState(desired, desired).Empty())
+}
@@ -36,8 +36,12 @@ const (
 	Backend4MapV2Name = "cilium_lb4_backends_v2"
 	// Backend4MapV3Name is the name of the IPv4 LB backends v3 BPF map.
 	Backend4MapV3Name = "cilium_lb4_backends_v3"
-	// RevNat4MapName is the name of the IPv4 LB reverse NAT BPF map.
+	// RevNat4MapName is the name of the IPv4 LB reverse NAT BPF map
+	// without the protocol in its value. It is only used to migrate
+	// entries into the v2 map.
 	RevNat4MapName = "cilium_lb4_reverse_nat"
+	// RevNat4MapV2Name is the name of the IPv4 LB reverse NAT v2 BPF map.
+	RevNat4MapV2Name = "cilium_lb4_reverse_nat_v2"
 )
 
 var (
@@ -57,6 +61,8 @@ var (
 	Backend4MapV3 *bpf.Map
 	// RevNat4Map is the IPv4 LB reverse NAT BPF map.
 	RevNat4Map *bpf.Map
+	// RevNat4MapV2 is the IPv4 LB reverse NAT v2 BPF map.
+	RevNat4MapV2 *bpf.Map
 	// SockRevNat4Map is the IPv4 LB sock reverse NAT BPF map.
 	SockRevNat4Map *bpf.Map
 )
@@ -110,6 +116,14 @@ func initSVC(params InitParams) {
 			0,
 		).WithCache().WithPressureMetric().
 			WithEvents(option.Config.GetEventBufferConfig(RevNat4MapName))
+		RevNat4MapV2 = bpf.NewMap(RevNat4MapV2Name,
+			ebpf.Hash,
+			&RevNat4Key{},
+			&RevNat4ValueV2{},
+			RevNatMapMaxEntries,
+			0,
+		).WithCache().WithPressureMetric().
+			WithEvents(option.Config.GetEventBufferConfig(RevNat4MapV2Name))
 	}
 
 	if params.IPv6 {
@@ -153,12 +167,21 @@ func initSVC(params InitParams) {
 			0,
 		).WithCache().WithPressureMetric().
 			WithEvents(option.Config.GetEventBufferConfig(RevNat6MapName))
+		RevNat6MapV2 = bpf.NewMap(RevNat6MapV2Name,
+			ebpf.Hash,
+			&RevNat6Key{},
+			&RevNat6ValueV2{},
+			RevNatMapMaxEntries,
+			0,
+		).WithCache().WithPressureMetric().
+			WithEvents(option.Config.GetEventBufferConfig(RevNat6MapV2Name))
 	}
 }
 
 // The compile-time check for whether the structs implement the interfaces
 var _ RevNatKey = (*RevNat4Key)(nil)
 var _ RevNatValue = (*RevNat4Value)(nil)
+var _ RevNatValue = (*RevNat4ValueV2)(nil)
 var _ ServiceKey = (*Service4Key)(nil)
 var _ ServiceValue = (*Service4Value)(nil)
 var _ BackendKey = (*Backend4Key)(nil)
@@ -177,7 +200,7 @@ func NewRevNat4Key(value uint16) *RevNat4Key {
 	return &RevNat4Key{value}
 }
 
-func (k *RevNat4Key) Map() *bpf.Map   { return RevNat4Map }
+func (k *RevNat4Key) Map() *bpf.Map   { return RevNat4MapV2 }
 func (k *RevNat4Key) String() string  { return fmt.Sprintf("%d", k.ToHost().(*RevNat4Key).Key) }
 func (k *RevNat4Key) New() bpf.MapKey { return &RevNat4Key{} }
 func (k *RevNat4Key) GetKey() uint16  { return k.Key }
@@ -222,6 +245,38 @@ func (v *RevNat4Value) String() string {
 
 func (v *RevNat4Value) New() bpf.MapValue { return &RevNat4Value{} }
 
+// RevNat4ValueV2 must match 'struct lb4_reverse_nat' in "bpf/lib/common.h".
+// It extends the v1 layout with the L4 protocol of the frontend so that the
+// reverse translation remains unambiguous when TCP and UDP services reuse an
+// ID.
+type RevNat4ValueV2 struct {
+	Address types.IPv4 `align:"address"`
+	Port    uint16     `align:"port"`
+	Proto   uint8      `align:"proto"`
+	Pad     uint8      `align:"pad"`
+}
+
+// ToNetwork converts RevNat4ValueV2 to network byte order.
+func (v *RevNat4ValueV2) ToNetwork() RevNatValue {
+	n := *v
+	n.Port = byteorder.HostToNetwork16(n.Port)
+	return &n
+}
+
+// ToHost converts RevNat4ValueV2 to host byte order.
+func (k *RevNat4ValueV2) ToHost() RevNatValue {
+	h := *k
+	h.Port = byteorder.NetworkToHost16(h.Port)
+	return &h
+}
+
+func (v *RevNat4ValueV2) String() string {
+	vHost := v.ToHost().(*RevNat4ValueV2)
+	return net.JoinHostPort(vHost.Address.String(), fmt.Sprintf("%d", vHost.Port))
+}
+
+func (v *RevNat4ValueV2) New() bpf.MapValue { return &RevNat4ValueV2{} }
+
 type pad2uint8 [2]uint8
 
 // Service4Key must match 'struct lb4_key' in "bpf/lib/common.h".
@@ -271,9 +326,10 @@ func (k *Service4Key) GetPort() uint16         { return k.Port }
 func (k *Service4Key) MapDelete() error        { return k.Map().Delete(k.ToNetwork()) }
 
 func (k *Service4Key) RevNatValue() RevNatValue {
-	return &RevNat4Value{
+	return &RevNat4ValueV2{
 		Address: k.Address,
 		Port:    k.Port,
+		Proto:   k.Proto,
 	}
 }
 
@@ -292,12 +348,14 @@ func (k *Service4Key) ToHost() ServiceKey {
 
 // Service4Value must match 'struct lb4_service' in "bpf/lib/common.h".
 type Service4Value struct {
-	BackendID uint32    `align:"$union0"`
-	Count     uint16    `align:"count"`
-	RevNat    uint16    `align:"rev_nat_index"`
-	Flags     uint8     `align:"flags"`
-	Flags2    uint8     `align:"flags2"`
-	Pad       pad2uint8 `align:"pad"`
+	BackendID uint32 `align:"$union0"`
+	Count     uint16 `align:"count"`
+	RevNat    uint16 `align:"rev_nat_index"`
+	Flags     uint8  `align:"flags"`
+	Flags2    uint8  `align:"flags2"`
+	// SrcRangeSetID is in network byte order, zero when the source ranges
+	// are keyed by RevNat.
+	SrcRangeSetID uint16 `align:"src_range_set_id"`
 }
 
 func (s *Service4Value) New() bpf.MapValue { return &Service4Value{} }
@@ -307,11 +365,13 @@ func (s *Service4Value) String() string {
 	return fmt.Sprintf("%d %d (%d) [0x%x 0x%x]", sHost.BackendID, sHost.Count, sHost.RevNat, sHost.Flags, sHost.Flags2)
 }
 
-func (s *Service4Value) SetCount(count int)   { s.Count = uint16(count) }
-func (s *Service4Value) GetCount() int        { return int(s.Count) }
-func (s *Service4Value) SetRevNat(id int)     { s.RevNat = uint16(id) }
-func (s *Service4Value) GetRevNat() int       { return int(s.RevNat) }
-func (s *Service4Value) RevNatKey() RevNatKey { return &RevNat4Key{s.RevNat} }
+func (s *Service4Value) SetCount(count int) { s.Count = uint16(count) }
+func (s *Service4Value) GetCount() int      { return int(s.Count) }
+func (s *Service4Value) SetRevNat(id int)   { s.RevNat = uint16(id) }
+func (s *Service4Value) GetRevNat() int     { return int(s.RevNat) }
+
+func (s *Service4Value) SetSrcRangeSetID(id uint16) { s.SrcRangeSetID = id }
+func (s *Service4Value) RevNatKey() RevNatKey       { return &RevNat4Key{s.RevNat} }
 func (s *Service4Value) SetFlags(flags uint16) {
 	s.Flags = uint8(flags & 0xff)
 	s.Flags2 = uint8(flags >> 8)
@@ -343,6 +403,7 @@ func (s *Service4Value) GetBackendID() loadbalancer.BackendID {
 func (s *Service4Value) ToNetwork() ServiceValue {
 	n := *s
 	n.RevNat = byteorder.HostToNetwork16(n.RevNat)
+	n.SrcRangeSetID = byteorder.HostToNetwork16(n.SrcRangeSetID)
 	return &n
 }
 
@@ -350,6 +411,7 @@ func (s *Service4Value) ToNetwork() ServiceValue {
 func (s *Service4Value) ToHost() ServiceValue {
 	h := *s
 	h.RevNat = byteorder.NetworkToHost16(h.RevNat)
+	h.SrcRangeSetID = byteorder.NetworkToHost16(h.SrcRangeSetID)
 	return &h
 }
 
@@ -37,8 +37,12 @@ const (
 	Backend6MapV2Name = "cilium_lb6_backends_v2"
 	// Backend6MapV3Name is the name of the IPv6 LB backends v3 BPF map.
 	Backend6MapV3Name = "cilium_lb6_backends_v3"
-	// RevNat6MapName is the name of the IPv6 LB reverse NAT BPF map.
+	// RevNat6MapName is the name of the IPv6 LB reverse NAT BPF map
+	// without the protocol in its value. It is only used to migrate
+	// entries into the v2 map.
 	RevNat6MapName = "cilium_lb6_reverse_nat"
+	// RevNat6MapV2Name is the name of the IPv6 LB reverse NAT v2 BPF map.
+	RevNat6MapV2Name = "cilium_lb6_reverse_nat_v2"
 )
 
 var (
@@ -58,6 +62,8 @@ var (
 	Backend6MapV3 *bpf.Map
 	// RevNat6Map is the IPv6 LB reverse NAT BPF map.
 	RevNat6Map *bpf.Map
+	// RevNat6MapV2 is the IPv6 LB reverse NAT v2 BPF map.
+	RevNat6MapV2 *bpf.Map
 	// SockRevNat6Map is the IPv6 LB sock reverse NAT BPF map.
 	SockRevNat6Map *bpf.Map
 )
@@ -65,6 +71,7 @@ var (
 // The compile-time check for whether the structs implement the interfaces
 var _ RevNatKey = (*RevNat6Key)(nil)
 var _ RevNatValue = (*RevNat6Value)(nil)
+var _ RevNatValue = (*RevNat6ValueV2)(nil)
 var _ ServiceKey = (*Service6Key)(nil)
 var _ ServiceValue = (*Service6Value)(nil)
 var _ BackendKey = (*Backend6Key)(nil)
@@ -79,7 +86,7 @@ func NewRevNat6Key(value uint16) *RevNat6Key {
 	return &RevNat6Key{value}
 }
 
-func (v *RevNat6Key) Map() *bpf.Map   { return RevNat6Map }
+func (v *RevNat6Key) Map() *bpf.Map   { return RevNat6MapV2 }
 func (v *RevNat6Key) String() string  { return fmt.Sprintf("%d", v.ToHost().(*RevNat6Key).Key) }
 func (v *RevNat6Key) New() bpf.MapKey { return &RevNat6Key{} }
 func (v *RevNat6Key) GetKey() uint16  { return v.Key }
@@ -124,6 +131,38 @@ func (v *RevNat6Value) ToHost() RevNatValue {
 	return &h
 }
 
+// RevNat6ValueV2 must match 'struct lb6_reverse_nat' in "bpf/lib/common.h".
+// It extends the v1 layout with the L4 protocol of the frontend so that the
+// reverse translation remains unambiguous when TCP and UDP services reuse an
+// ID.
+type RevNat6ValueV2 struct {
+	Address types.IPv6 `align:"address"`
+	Port    uint16     `align:"port"`
+	Proto   uint8      `align:"proto"`
+	Pad     uint8      `align:"pad"`
+}
+
+func (v *RevNat6ValueV2) String() string {
+	vHost := v.ToHost().(*RevNat6ValueV2)
+	return net.JoinHostPort(vHost.Address.String(), fmt.Sprintf("%d", vHost.Port))
+}
+
+func (v *RevNat6ValueV2) New() bpf.MapValue { return &RevNat6ValueV2{} }
+
+// ToNetwork converts RevNat6ValueV2 to network byte order.
+func (v *RevNat6ValueV2) ToNetwork() RevNatValue {
+	n := *v
+	n.Port = byteorder.HostToNetwork16(n.Port)
+	return &n
+}
+
+// ToHost converts RevNat6ValueV2 to host byte order.
+func (v *RevNat6ValueV2) ToHost() RevNatValue {
+	h := *v
+	h.Port = byteorder.NetworkToHost16(h.Port)
+	return &h
+}
+
 // Service6Key must match 'struct lb6_key' in "bpf/lib/common.h".
 type Service6Key struct {
 	Address     types.IPv6 `align:"address"`
@@ -170,9 +209,10 @@ func (k *Service6Key) GetPort() uint16         { return k.Port }
 func (k *Service6Key) MapDelete() error        { return k.Map().Delete(k.ToNetwork()) }
 
 func (k *Service6Key) RevNatValue() RevNatValue {
-	return &RevNat6Value{
+	return &RevNat6ValueV2{
 		Address: k.Address,
 		Port:    k.Port,
+		Proto:   k.Proto,
 	}
 }
 
@@ -191,12 +231,14 @@ func (k *Service6Key) ToHost() ServiceKey {
 
 // Service6Value must match 'struct lb6_service' in "bpf/lib/common.h".
 type Service6Value struct {
-	BackendID uint32    `align:"$union0"`
-	Count     uint16    `align:"count"`
-	RevNat    uint16    `align:"rev_nat_index"`
-	Flags     uint8     `align:"flags"`
-	Flags2    uint8     `align:"flags2"`
-	Pad       pad2uint8 `align:"pad"`
+	BackendID uint32 `align:"$union0"`
+	Count     uint16 `align:"count"`
+	RevNat    uint16 `align:"rev_nat_index"`
+	Flags     uint8  `align:"flags"`
+	Flags2    uint8  `align:"flags2"`
+	// SrcRangeSetID is in network byte order, zero when the source ranges
+	// are keyed by RevNat.
+	SrcRangeSetID uint16 `align:"src_range_set_id"`
 }
 
 func (s *Service6Value) New() bpf.MapValue { return &Service6Value{} }
@@ -206,11 +248,13 @@ func (s *Service6Value) String() string {
 	return fmt.Sprintf("%d %d (%d) [0x%x 0x%x]", sHost.BackendID, sHost.Count, sHost.RevNat, sHost.Flags, sHost.Flags2)
 }
 
-func (s *Service6Value) SetCount(count int)   { s.Count = uint16(count) }
-func (s *Service6Value) GetCount() int        { return int(s.Count) }
-func (s *Service6Value) SetRevNat(id int)     { s.RevNat = uint16(id) }
-func (s *Service6Value) GetRevNat() int       { return int(s.RevNat) }
-func (s *Service6Value) RevNatKey() RevNatKey { return &RevNat6Key{s.RevNat} }
+func (s *Service6Value) SetCount(count int) { s.Count = uint16(count) }
+func (s *Service6Value) GetCount() int      { return int(s.Count) }
+func (s *Service6Value) SetRevNat(id int)   { s.RevNat = uint16(id) }
+func (s *Service6Value) GetRevNat() int     { return int(s.RevNat) }
+
+func (s *Service6Value) SetSrcRangeSetID(id uint16) { s.SrcRangeSetID = id }
+func (s *Service6Value) RevNatKey() RevNatKey       { return &RevNat6Key{s.RevNat} }
 func (s *Service6Value) SetFlags(flags uint16) {
 	s.Flags = uint8(flags & 0xff)
 	s.Flags2 = uint8(flags >> 8)
@@ -241,6 +285,7 @@ func (s *Service6Value) GetBackendID() loadbalancer.BackendID {
 func (s *Service6Value) ToNetwork() ServiceValue {
 	n := *s
 	n.RevNat = byteorder.HostToNetwork16(n.RevNat)
+	n.SrcRangeSetID = byteorder.HostToNetwork16(n.SrcRangeSetID)
 	return &n
 }
 
@@ -248,6 +293,7 @@ func (s *Service6Value) ToNetwork() ServiceValue {
 func (s *Service6Value) ToHost() ServiceValue {
 	h := *s
 	h.RevNat = byteorder.NetworkToHost16(h.RevNat)
+	h.SrcRangeSetID = byteorder.NetworkToHost16(h.SrcRangeSetID)
 	return &h
 }
 
@@ -47,13 +47,18 @@ type LBBPFMap struct {
 	// pkg/service.go:(Service).UpsertService() lock.
 	maglevBackendIDsBuffer []loadbalancer.BackendID
 	maglevTableSize        uint64
+
+	// backendSelector overrides the order in which backends are programmed
+	// into the backend slots. May be nil, in which case the default order
+	// is used.
+	backendSelector datapathTypes.BackendSelector
 }
 
-func New() *LBBPFMap {
+func New(backendSelector datapathTypes.BackendSelector) *LBBPFMap {
 	maglev := option.Config.NodePortAlg == option.NodePortAlgMaglev
 	maglevTableSize := option.Config.MaglevTableSize
 
-	m := &LBBPFMap{}
+	m := &LBBPFMap{backendSelector: backendSelector}
 
 	if maglev {
 		m.maglevBackendIDsBuffer = make([]loadbalancer.BackendID, maglevTableSize)
@@ -98,6 +103,9 @@ func (lbmap *LBBPFMap) upsertServiceProto(p *datapathTypes.UpsertServiceParams,
 			}
 		}
 		backendIDs := p.GetOrderedBackends()
+		if lbmap.backendSelector != nil {
+			backendIDs = lbmap.backendSelector.OrderBackends(p, backendIDs)
+		}
 		for _, backendID := range backendIDs {
 			if backendID == 0 {
 				return fmt.Errorf("Invalid backend ID 0")
@@ -129,7 +137,7 @@ func (lbmap *LBBPFMap) upsertServiceProto(p *datapathTypes.UpsertServiceParams,
 	}
 
 	if err := updateMasterService(svcKey, svcVal.New().(ServiceValue), len(backends), int(p.ID), p.Type, p.ExtLocal, p.IntLocal, p.NatPolicy,
-		p.SessionAffinity, p.SessionAffinityTimeoutSec, p.CheckSourceRange, p.L7LBProxyPort, p.LoopbackHostport); err != nil {
+		p.SessionAffinity, p.SessionAffinityTimeoutSec, p.CheckSourceRange, p.SourceRangeSetID, p.L7LBProxyPort, p.LoopbackHostport); err != nil {
 		deleteRevNatLocked(revNATKey)
 		return fmt.Errorf("Unable to update service %+v: %w", svcKey, err)
 	}
@@ -391,9 +399,13 @@ func deleteRevNatLocked(key RevNatKey) error {
 	return key.Map().Delete(key.ToNetwork())
 }
 
-func (*LBBPFMap) UpdateSourceRanges(revNATID uint16, prevSourceRanges []*cidr.CIDR,
-	sourceRanges []*cidr.CIDR, ipv6 bool) error {
-
+// UpdateSourceRanges programs the given source ranges for the service
+// identified by revNATID and returns the ID of the shared source range set
+// keying the LPM entries. Services with identical source ranges share one
+// set of entries; the entries are removed once the last service stops
+// referencing the set. An empty set of ranges releases the service's
+// reference and returns a zero set ID.
+func (*LBBPFMap) UpdateSourceRanges(revNATID uint16, sourceRanges []*cidr.CIDR, ipv6 bool) (uint16, error) {
 	m := SourceRange4Map
 	if ipv6 {
 		m = SourceRange6Map
@@ -412,18 +424,118 @@ func (*LBBPFMap) UpdateSourceRanges(revNATID uint16, prevSourceRanges []*cidr.CI
 		srcRangeMap[cidr.String()] = cidr
 	}
 
-	for _, prevCIDR := range prevSourceRanges {
-		if _, found := srcRangeMap[prevCIDR.String()]; !found {
-			if err := m.Delete(srcRangeKey(prevCIDR, revNATID, ipv6)); err != nil {
+	svcKey := srcRangeSvcKey(revNATID, ipv6)
+
+	srcRangeSets.Lock()
+	defer srcRangeSets.Unlock()
+
+	if len(srcRangeMap) == 0 {
+		return 0, releaseSrcRangeSetLocked(svcKey, m, ipv6)
+	}
+
+	hash := srcRangeSetHash(srcRangeMap, ipv6)
+	if prev, ok := srcRangeSets.hashBySvc[svcKey]; ok && prev == hash {
+		return srcRangeSets.byHash[hash].id, nil
+	}
+
+	if err := releaseSrcRangeSetLocked(svcKey, m, ipv6); err != nil {
+		return 0, err
+	}
+
+	set, ok := srcRangeSets.byHash[hash]
+	if !ok {
+		id, err := allocSrcRangeSetIDLocked()
+		if err != nil {
+			return 0, err
+		}
+		set = &srcRangeSet{id: id, ranges: make([]*cidr.CIDR, 0, len(srcRangeMap))}
+		for _, cidr := range srcRangeMap {
+			if err := m.Update(srcRangeKey(cidr, id, ipv6), &SourceRangeValue{}); err != nil {
+				// Remove the entries inserted so far; the ID may only be
+				// released for reuse once none of its LPM entries remain,
+				// otherwise a later set reusing it inherits stale CIDRs.
+				cleaned := true
+				for _, c := range set.ranges {
+					if m.Delete(srcRangeKey(c, id, ipv6)) != nil {
+						cleaned = false
+					}
+				}
+				if cleaned {
+					srcRangeSets.freeIDs = append(srcRangeSets.freeIDs, id)
+				}
+				return 0, err
+			}
+			set.ranges = append(set.ranges, cidr)
+		}
+		srcRangeSets.byHash[hash] = set
+	}
+	set.refcount++
+	srcRangeSets.hashBySvc[svcKey] = hash
+
+	return set.id, nil
+}
+
+// RestoreSourceRangeSets rebuilds the in-memory state of the shared source
+// range sets from the entries dumped from the datapath on agent restart.
+// Restored services still reference the set IDs from their service map
+// values, so the sets must be known before orphaned entries are removed, and
+// services synced from k8s afterwards reuse them instead of allocating fresh
+// IDs. Sets left unclaimed once the sync with k8s has finished are removed
+// by PruneSourceRangeSets.
+func (*LBBPFMap) RestoreSourceRangeSets(sets datapathTypes.SourceRangeSetByServiceID, ipv6 bool) {
+	srcRangeSets.Lock()
+	defer srcRangeSets.Unlock()
+
+	for id, ranges := range sets {
+		srcRangeMap := make(map[string]*cidr.CIDR, len(ranges))
+		for _, c := range ranges {
+			srcRangeMap[c.String()] = c
+		}
+		srcRangeSets.byHash[srcRangeSetHash(srcRangeMap, ipv6)] = &srcRangeSet{id: id, ranges: ranges}
+		if id >= srcRangeSets.nextID {
+			srcRangeSets.nextID = id + 1
+		}
+	}
+}
+
+// PruneSourceRangeSets removes the LPM entries of restored source range sets
+// which have not been claimed by any service during the sync with k8s.
+func (*LBBPFMap) PruneSourceRangeSets(ipv6 bool) error {
+	m := SourceRange4Map
+	if ipv6 {
+		m = SourceRange6Map
+	}
+
+	srcRangeSets.Lock()
+	defer srcRangeSets.Unlock()
+
+	for hash, set := range srcRangeSets.byHash {
+		if set.refcount > 0 || srcRangeSetHashIsIPv6(hash) != ipv6 {
+			continue
+		}
+		for _, c := range set.ranges {
+			if err := m.Delete(srcRangeKey(c, set.id, ipv6)); err != nil {
 				return err
 			}
-		} else {
-			delete(srcRangeMap, prevCIDR.String())
 		}
+		delete(srcRangeSets.byHash, hash)
+		srcRangeSets.freeIDs = append(srcRangeSets.freeIDs, set.id)
+	}
+
+	return nil
+}
+
+// DeleteSourceRanges removes the source range entries programmed under the
+// given ID. It is only used to clean up entries restored from the datapath
+// which are not referenced by any service.
+func (*LBBPFMap) DeleteSourceRanges(id uint16, sourceRanges []*cidr.CIDR, ipv6 bool) error {
+	m := SourceRange4Map
+	if ipv6 {
+		m = SourceRange6Map
 	}
 
-	for _, cidr := range srcRangeMap {
-		if err := m.Update(srcRangeKey(cidr, revNATID, ipv6), &SourceRangeValue{}); err != nil {
+	for _, cidr := range sourceRanges {
+		if err := m.Delete(srcRangeKey(cidr, id, ipv6)); err != nil {
 			return err
 		}
 	}
@@ -567,7 +679,8 @@ func (*LBBPFMap) IsMaglevLookupTableRecreated(ipv6 bool) bool {
 
 func updateMasterService(fe ServiceKey, v ServiceValue, activeBackends int, revNATID int, svcType loadbalancer.SVCType,
 	svcExtLocal, svcIntLocal bool, svcNatPolicy loadbalancer.SVCNatPolicy, sessionAffinity bool,
-	sessionAffinityTimeoutSec uint32, checkSourceRange bool, l7lbProxyPort uint16, loopbackHostport bool) error {
+	sessionAffinityTimeoutSec uint32, checkSourceRange bool, srcRangeSetID uint16,
+	l7lbProxyPort uint16, loopbackHostport bool) error {
 
 	// isRoutable denotes whether this service can be accessed from outside the cluster.
 	isRoutable := !fe.IsSurrogate() &&
@@ -588,6 +701,9 @@ func updateMasterService(fe ServiceKey, v ServiceValue, activeBackends int, revN
 		LoopbackHostport: loopbackHostport,
 	})
 	v.SetFlags(flag.UInt16())
+	if checkSourceRange {
+		v.SetSrcRangeSetID(srcRangeSetID)
+	}
 	if sessionAffinity {
 		v.SetSessionAffinityTimeoutSec(sessionAffinityTimeoutSec)
 	}
@@ -731,6 +847,55 @@ func Init(params InitParams) {
 	initSourceRange(params)
 }
 
+// PruneSockRevNat removes sock rev nat entries whose reverse NAT index does
+// not refer to any of the given service IDs. It returns the number of
+// entries removed. Entries left behind by heavy service churn would
+// otherwise only age out via the LRU eviction of the map.
+func (*LBBPFMap) PruneSockRevNat(knownRevNatIDs map[uint16]struct{}) (int, error) {
+	pruned := 0
+	var errs error
+
+	if SockRevNat4Map != nil && SockRevNat4Map.IsOpen() {
+		toDelete := []*SockRevNat4Key{}
+		err := SockRevNat4Map.DumpWithCallback(func(key bpf.MapKey, value bpf.MapValue) {
+			if v := value.(*SockRevNat4Value); v.RevNatIndex != 0 {
+				if _, ok := knownRevNatIDs[v.RevNatIndex]; !ok {
+					toDelete = append(toDelete, key.(*SockRevNat4Key))
+				}
+			}
+		})
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("dumping %s: %w", SockRevNat4Map.Name(), err))
+		}
+		for _, key := range toDelete {
+			if deleted, _ := SockRevNat4Map.SilentDelete(key); deleted {
+				pruned++
+			}
+		}
+	}
+
+	if SockRevNat6Map != nil && SockRevNat6Map.IsOpen() {
+		toDelete := []*SockRevNat6Key{}
+		err := SockRevNat6Map.DumpWithCallback(func(key bpf.MapKey, value bpf.MapValue) {
+			if v := value.(*SockRevNat6Value); v.revNatIndex != 0 {
+				if _, ok := knownRevNatIDs[v.revNatIndex]; !ok {
+					toDelete = append(toDelete, key.(*SockRevNat6Key))
+				}
+			}
+		})
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("dumping %s: %w", SockRevNat6Map.Name(), err))
+		}
+		for _, key := range toDelete {
+			if deleted, _ := SockRevNat6Map.SilentDelete(key); deleted {
+				pruned++
+			}
+		}
+	}
+
+	return pruned, errs
+}
+
 // ExistsSockRevNat checks if the passed entry exists in the sock rev nat map.
 func (*LBBPFMap) ExistsSockRevNat(cookie uint64, addr net.IP, port uint16) bool {
 	if addr.To4() != nil {
@@ -104,6 +104,15 @@ func deleteMapIfMNotMatch(mapName string, tableSize uint32) (bool, error) {
 
 // updateMaglevTable creates a new inner Maglev map containing the given backend IDs
 // and sets it as the active lookup table for the given service ID.
+//
+// The table size is necessarily the same for all services: the kernel
+// requires every inner map of an outer map to share the geometry of the
+// template inner map (bpf_map_meta_equal), and the lookup table size is
+// encoded in the inner map's value size. The datapath likewise bakes the
+// size into the programs as LB_MAGLEV_LUT_SIZE for the modulo of the
+// 5-tuple hash. Supporting per-service table sizes would thus require both
+// a different inner map geometry and a datapath-readable per-service size,
+// neither of which the current layout provides.
 func updateMaglevTable(ipv6 bool, revNATID uint16, backendIDs []loadbalancer.BackendID) error {
 	outer := maglevOuter4Map
 	if ipv6 {
@@ -76,7 +76,7 @@ func TestInitMaps(t *testing.T) {
 	// Now insert the entry, so that the map should not be removed
 	err = InitMaglevMaps(true, false, uint32(option.Config.MaglevTableSize))
 	require.NoError(t, err)
-	lbm := New()
+	lbm := New(nil)
 	params := &datapathTypes.UpsertServiceParams{
 		ID:   1,
 		IP:   net.ParseIP("1.1.1.1"),
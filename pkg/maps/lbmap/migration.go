@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package lbmap
+
+import (
+	"fmt"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	cmtypes "github.com/cilium/cilium/pkg/clustermesh/types"
+	lb "github.com/cilium/cilium/pkg/loadbalancer"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+)
+
+// migration describes how the entries of a previous LB map layout are carried
+// over into the current one. It replaces the ad-hoc per-release migration
+// code which used to live in the service package.
+type migration struct {
+	// From is the map holding the old layout. It is only opened, never
+	// created; a migration is a no-op if the map is not pinned.
+	From *bpf.Map
+
+	// To is the current map the entries are migrated into. It must have
+	// been opened before the migration runs.
+	To *bpf.Map
+
+	// Transform converts an old key/value pair into the current layout.
+	// Keys carrying allocated IDs must be preserved by the transform so
+	// that existing connections survive the upgrade.
+	Transform func(key bpf.MapKey, value bpf.MapValue) (bpf.MapKey, bpf.MapValue, error)
+}
+
+// migrations returns the list of migrations applicable to the currently
+// initialized maps. Maps not initialized (e.g. due to a disabled address
+// family) yield no migration.
+func migrations() []migration {
+	var ms []migration
+
+	if Backend4MapV2 != nil && Backend4MapV3 != nil {
+		ms = append(ms, migration{
+			From:      Backend4MapV2,
+			To:        Backend4MapV3,
+			Transform: backend4V2ToV3,
+		})
+	}
+	if Backend6MapV2 != nil && Backend6MapV3 != nil {
+		ms = append(ms, migration{
+			From:      Backend6MapV2,
+			To:        Backend6MapV3,
+			Transform: backend6V2ToV3,
+		})
+	}
+	if RevNat4Map != nil && RevNat4MapV2 != nil {
+		ms = append(ms, migration{
+			From:      RevNat4Map,
+			To:        RevNat4MapV2,
+			Transform: revNat4V1ToV2,
+		})
+	}
+	if RevNat6Map != nil && RevNat6MapV2 != nil {
+		ms = append(ms, migration{
+			From:      RevNat6Map,
+			To:        RevNat6MapV2,
+			Transform: revNat6V1ToV2,
+		})
+	}
+
+	return ms
+}
+
+func backend4V2ToV3(key bpf.MapKey, value bpf.MapValue) (bpf.MapKey, bpf.MapValue, error) {
+	v2Val := value.(*Backend4Value)
+	addrCluster := cmtypes.AddrClusterFrom(v2Val.Address.Addr(), 0)
+	v3Val, err := NewBackend4ValueV3(
+		addrCluster,
+		v2Val.Port,
+		v2Val.Proto,
+		lb.GetBackendStateFromFlags(v2Val.Flags),
+		0,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, v3Val, nil
+}
+
+func backend6V2ToV3(key bpf.MapKey, value bpf.MapValue) (bpf.MapKey, bpf.MapValue, error) {
+	v2Val := value.(*Backend6Value)
+	addrCluster := cmtypes.AddrClusterFrom(v2Val.Address.Addr(), 0)
+	v3Val, err := NewBackend6ValueV3(
+		addrCluster,
+		v2Val.Port,
+		v2Val.Proto,
+		lb.GetBackendStateFromFlags(v2Val.Flags),
+		0,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, v3Val, nil
+}
+
+// revNat4V1ToV2 carries a v1 reverse NAT entry over into the v2 layout. The
+// protocol of a migrated entry is unknown and left at zero (any); it is
+// rewritten with the frontend's protocol on the first service sync.
+func revNat4V1ToV2(key bpf.MapKey, value bpf.MapValue) (bpf.MapKey, bpf.MapValue, error) {
+	v1Val := value.(*RevNat4Value)
+	return key, &RevNat4ValueV2{
+		Address: v1Val.Address,
+		Port:    v1Val.Port,
+	}, nil
+}
+
+func revNat6V1ToV2(key bpf.MapKey, value bpf.MapValue) (bpf.MapKey, bpf.MapValue, error) {
+	v1Val := value.(*RevNat6Value)
+	return key, &RevNat6ValueV2{
+		Address: v1Val.Address,
+		Port:    v1Val.Port,
+	}, nil
+}
+
+// run migrates all entries of the old map into the current one and unpins
+// the old map afterwards.
+func (m migration) run() error {
+	scopedLog := log.WithField(logfields.BPFMapName, m.From.Name())
+	scopedLog.WithField("target", m.To.Name()).Info("Migrating map entries to new layout")
+
+	err := m.From.DumpWithCallback(func(key bpf.MapKey, value bpf.MapValue) {
+		newKey, newValue, err := m.Transform(key, value)
+		if err != nil {
+			scopedLog.WithError(err).Debug("Error transforming map entry")
+			return
+		}
+		if err := m.To.Update(newKey, newValue); err != nil {
+			scopedLog.WithError(err).Warn("Error updating map")
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("unable to migrate %s: %w", m.From.Name(), err)
+	}
+
+	// The old map will be removed from bpffs at this point, the map will
+	// be actually removed once the last program referencing it has been
+	// removed.
+	if err := m.From.Close(); err != nil {
+		scopedLog.WithError(err).Warn("Error closing map")
+	}
+	if err := m.From.Unpin(); err != nil {
+		scopedLog.WithError(err).Warn("Error unpinning map")
+	}
+
+	return nil
+}
+
+// MigrateMaps detects pinned maps with an old key/value layout and migrates
+// their entries into the current maps, preserving allocated IDs. It must be
+// called after the current maps have been opened, but before any service is
+// upserted.
+func MigrateMaps() error {
+	for _, m := range migrations() {
+		if m.From.Open() != nil {
+			// Old map does not exist, nothing to migrate.
+			continue
+		}
+		if err := m.run(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
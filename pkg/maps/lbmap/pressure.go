@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package lbmap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cilium/ebpf"
+
+	"github.com/cilium/cilium/pkg/bpf"
+	"github.com/cilium/cilium/pkg/controller"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+// pressureInterval is the interval in which the LB map pressure is
+// recalculated from batch counts.
+const pressureInterval = 30 * time.Second
+
+var pressureControllerGroup = controller.NewGroup("lb-map-pressure")
+
+// countBatch batch dumps the given map and returns the number of entries it
+// holds. The chunk size follows the reasoning in the equivalent CT map
+// helper: with batch sizes in the range of a few thousand entries, no ENOSPC
+// has been observed even for full maps.
+func countBatch[K any, V any](m *bpf.Map) (count int, err error) {
+	const chunkSize = 4096
+
+	kout := make([]K, chunkSize)
+	vout := make([]V, chunkSize)
+
+	var cursor ebpf.MapBatchCursor
+	for {
+		c, batchErr := m.BatchLookup(&cursor, kout, vout, nil)
+		count += c
+		if batchErr != nil {
+			if errors.Is(batchErr, ebpf.ErrKeyNotExist) {
+				return count, nil
+			}
+			return count, batchErr
+		}
+	}
+}
+
+// pressureMaps returns the list of LB maps for which the pressure metric is
+// computed, along with their typed batch count function. Only maps which
+// have been initialized and opened are returned.
+func pressureMaps() map[*bpf.Map]func(*bpf.Map) (int, error) {
+	maps := map[*bpf.Map]func(*bpf.Map) (int, error){
+		Service4MapV2:    countBatch[Service4Key, Service4Value],
+		Backend4MapV3:    countBatch[Backend4KeyV3, Backend4ValueV3],
+		RevNat4MapV2:     countBatch[RevNat4Key, RevNat4ValueV2],
+		Affinity4Map:     countBatch[Affinity4Key, AffinityValue],
+		SourceRange4Map:  countBatch[SourceRangeKey4, SourceRangeValue],
+		Service6MapV2:    countBatch[Service6Key, Service6Value],
+		Backend6MapV3:    countBatch[Backend6KeyV3, Backend6ValueV3],
+		RevNat6MapV2:     countBatch[RevNat6Key, RevNat6ValueV2],
+		Affinity6Map:     countBatch[Affinity6Key, AffinityValue],
+		SourceRange6Map:  countBatch[SourceRangeKey6, SourceRangeValue],
+		AffinityMatchMap: countBatch[AffinityMatchKey, AffinityMatchValue],
+	}
+	for m := range maps {
+		if m == nil || !m.IsOpen() {
+			delete(maps, m)
+		}
+	}
+	return maps
+}
+
+// CalculatePressure is a controller that batch counts the entries of all LB
+// maps and publishes the counts as part of the BPF map pressure metric. This
+// makes E2BIG conditions during service upserts predictable instead of only
+// being noticed once insertions start to fail.
+func CalculatePressure(mgr *controller.Manager) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	mgr.UpdateController("lb-map-pressure", controller.ControllerParams{
+		Group: pressureControllerGroup,
+		DoFunc: func(context.Context) error {
+			var errs error
+			for m, count := range pressureMaps() {
+				c, err := count(m)
+				if errors.Is(err, ebpf.ErrNotSupported) {
+					// We don't have batch ops, so cancel context to kill this
+					// controller.
+					cancel(err)
+					return err
+				}
+				if err != nil {
+					errs = errors.Join(errs, fmt.Errorf("failed to dump LB map %s: %w", m.Name(), err))
+					continue
+				}
+				m.UpdatePressureMetricWithSize(int32(c))
+			}
+			return errs
+		},
+		RunInterval: pressureInterval,
+		Context:     ctx,
+	})
+}
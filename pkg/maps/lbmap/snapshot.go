@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package lbmap
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cilium/cilium/pkg/bpf"
+)
+
+// SnapshotVersion is bumped whenever the snapshot format changes in an
+// incompatible way.
+const SnapshotVersion = 2
+
+// SnapshotEntry is a single key/value pair of one of the LB maps. The entries
+// are stored exactly as dumped, i.e. in network byte order where the map uses
+// it, so that a restore reproduces the map content bit for bit and preserves
+// all allocated service and backend IDs.
+type SnapshotEntry[K any, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+// Snapshot is the serializable content of the LB map set. It covers the
+// service, backend, reverse NAT, source range and affinity match maps.
+// Per-connection state (session affinity and socket reverse NAT entries) and
+// the Maglev lookup tables, which are derived from the backend entries, are
+// not included.
+type Snapshot struct {
+	Version int       `json:"version"`
+	Created time.Time `json:"created"`
+
+	Services4     []SnapshotEntry[Service4Key, Service4Value]           `json:"services4,omitempty"`
+	Services6     []SnapshotEntry[Service6Key, Service6Value]           `json:"services6,omitempty"`
+	Backends4     []SnapshotEntry[Backend4KeyV3, Backend4ValueV3]       `json:"backends4,omitempty"`
+	Backends6     []SnapshotEntry[Backend6KeyV3, Backend6ValueV3]       `json:"backends6,omitempty"`
+	RevNat4       []SnapshotEntry[RevNat4Key, RevNat4ValueV2]           `json:"revNat4,omitempty"`
+	RevNat6       []SnapshotEntry[RevNat6Key, RevNat6ValueV2]           `json:"revNat6,omitempty"`
+	SourceRanges4 []SnapshotEntry[SourceRangeKey4, SourceRangeValue]    `json:"sourceRanges4,omitempty"`
+	SourceRanges6 []SnapshotEntry[SourceRangeKey6, SourceRangeValue]    `json:"sourceRanges6,omitempty"`
+	AffinityMatch []SnapshotEntry[AffinityMatchKey, AffinityMatchValue] `json:"affinityMatch,omitempty"`
+}
+
+// dumpEntries collects all entries of the given map. A map that has not been
+// created yet yields no entries.
+func dumpEntries[K any, V any](m *bpf.Map) ([]SnapshotEntry[K, V], error) {
+	var entries []SnapshotEntry[K, V]
+	err := m.DumpWithCallbackIfExists(func(key bpf.MapKey, value bpf.MapValue) {
+		entries = append(entries, SnapshotEntry[K, V]{
+			Key:   *(any(key).(*K)),
+			Value: *(any(value).(*V)),
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to dump %s: %w", m.Name(), err)
+	}
+	return entries, nil
+}
+
+// restoreEntries writes the given entries into the map, creating it if it
+// does not exist. Entries already present in the map are overwritten.
+func restoreEntries[K any, V any](m *bpf.Map, entries []SnapshotEntry[K, V]) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	if err := m.OpenOrCreate(); err != nil {
+		return fmt.Errorf("unable to open %s: %w", m.Name(), err)
+	}
+	for i := range entries {
+		key := any(&entries[i].Key).(bpf.MapKey)
+		value := any(&entries[i].Value).(bpf.MapValue)
+		if err := m.Update(key, value); err != nil {
+			return fmt.Errorf("unable to restore entry %s in %s: %w",
+				key.String(), m.Name(), err)
+		}
+	}
+	return nil
+}
+
+// DumpSnapshot reads the content of the LB map set into a Snapshot. The map
+// objects must have been initialized with Init() beforehand; maps that do not
+// exist on the node are skipped.
+func DumpSnapshot() (*Snapshot, error) {
+	s := &Snapshot{
+		Version: SnapshotVersion,
+		Created: time.Now(),
+	}
+
+	var err error
+	if s.Services4, err = dumpEntries[Service4Key, Service4Value](Service4MapV2); err != nil {
+		return nil, err
+	}
+	if s.Services6, err = dumpEntries[Service6Key, Service6Value](Service6MapV2); err != nil {
+		return nil, err
+	}
+	if s.Backends4, err = dumpEntries[Backend4KeyV3, Backend4ValueV3](Backend4MapV3); err != nil {
+		return nil, err
+	}
+	if s.Backends6, err = dumpEntries[Backend6KeyV3, Backend6ValueV3](Backend6MapV3); err != nil {
+		return nil, err
+	}
+	if s.RevNat4, err = dumpEntries[RevNat4Key, RevNat4ValueV2](RevNat4MapV2); err != nil {
+		return nil, err
+	}
+	if s.RevNat6, err = dumpEntries[RevNat6Key, RevNat6ValueV2](RevNat6MapV2); err != nil {
+		return nil, err
+	}
+	if s.SourceRanges4, err = dumpEntries[SourceRangeKey4, SourceRangeValue](SourceRange4Map); err != nil {
+		return nil, err
+	}
+	if s.SourceRanges6, err = dumpEntries[SourceRangeKey6, SourceRangeValue](SourceRange6Map); err != nil {
+		return nil, err
+	}
+	if s.AffinityMatch, err = dumpEntries[AffinityMatchKey, AffinityMatchValue](AffinityMatchMap); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// RestoreSnapshot writes the content of the given Snapshot back into the LB
+// maps, creating maps as needed. Entries already present in the maps are
+// overwritten, entries not covered by the snapshot are left untouched.
+func RestoreSnapshot(s *Snapshot) error {
+	if s.Version != SnapshotVersion {
+		return fmt.Errorf("unsupported snapshot version %d (expected %d)",
+			s.Version, SnapshotVersion)
+	}
+
+	// Restore the backends before the services referencing them so that a
+	// concurrent datapath never selects a backend ID without an entry.
+	if err := restoreEntries(Backend4MapV3, s.Backends4); err != nil {
+		return err
+	}
+	if err := restoreEntries(Backend6MapV3, s.Backends6); err != nil {
+		return err
+	}
+	if err := restoreEntries(RevNat4MapV2, s.RevNat4); err != nil {
+		return err
+	}
+	if err := restoreEntries(RevNat6MapV2, s.RevNat6); err != nil {
+		return err
+	}
+	if err := restoreEntries(SourceRange4Map, s.SourceRanges4); err != nil {
+		return err
+	}
+	if err := restoreEntries(SourceRange6Map, s.SourceRanges6); err != nil {
+		return err
+	}
+	if err := restoreEntries(AffinityMatchMap, s.AffinityMatch); err != nil {
+		return err
+	}
+	if err := restoreEntries(Service4MapV2, s.Services4); err != nil {
+		return err
+	}
+	return restoreEntries(Service6MapV2, s.Services6)
+}
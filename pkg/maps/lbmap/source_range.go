@@ -6,11 +6,14 @@ package lbmap
 import (
 	"fmt"
 	"net"
+	"sort"
+	"strings"
 
 	"github.com/cilium/cilium/pkg/bpf"
 	"github.com/cilium/cilium/pkg/byteorder"
 	"github.com/cilium/cilium/pkg/cidr"
 	"github.com/cilium/cilium/pkg/ebpf"
+	"github.com/cilium/cilium/pkg/lock"
 	"github.com/cilium/cilium/pkg/option"
 	"github.com/cilium/cilium/pkg/types"
 )
@@ -169,6 +172,102 @@ func initSourceRange(params InitParams) {
 	}
 }
 
+// SourceRangeSetIDFirst is the first ID of the range reserved for shared
+// source range sets. Service IDs are allocated below this boundary (see
+// pkg/service.MaxSetOfServiceID), so shared sets never collide with source
+// range entries keyed by a reverse NAT identifier, as written by older
+// versions.
+const SourceRangeSetIDFirst = uint16(0xF000)
+
+// srcRangeSet describes a set of source range CIDRs shared between all
+// services with identical LoadBalancerSourceRanges. The LPM entries of the
+// set are keyed by its ID instead of the reverse NAT identifier of each
+// referencing service, deduplicating the entries.
+type srcRangeSet struct {
+	id       uint16
+	ranges   []*cidr.CIDR
+	refcount int
+}
+
+var srcRangeSets = struct {
+	lock.Mutex
+	byHash    map[string]*srcRangeSet
+	hashBySvc map[uint32]string
+	nextID    uint16
+	freeIDs   []uint16
+}{
+	byHash:    map[string]*srcRangeSet{},
+	hashBySvc: map[uint32]string{},
+	nextID:    SourceRangeSetIDFirst,
+}
+
+func srcRangeSvcKey(revNATID uint16, ipv6 bool) uint32 {
+	key := uint32(revNATID)
+	if ipv6 {
+		key |= 1 << 16
+	}
+	return key
+}
+
+func srcRangeSetHashIsIPv6(hash string) bool {
+	return strings.HasPrefix(hash, "6:")
+}
+
+func srcRangeSetHash(ranges map[string]*cidr.CIDR, ipv6 bool) string {
+	strs := make([]string, 0, len(ranges))
+	for s := range ranges {
+		strs = append(strs, s)
+	}
+	sort.Strings(strs)
+	prefix := "4:"
+	if ipv6 {
+		prefix = "6:"
+	}
+	return prefix + strings.Join(strs, ",")
+}
+
+func allocSrcRangeSetIDLocked() (uint16, error) {
+	if n := len(srcRangeSets.freeIDs); n > 0 {
+		id := srcRangeSets.freeIDs[n-1]
+		srcRangeSets.freeIDs = srcRangeSets.freeIDs[:n-1]
+		return id, nil
+	}
+	// nextID wraps to zero once the reserved space is exhausted.
+	if srcRangeSets.nextID == 0 {
+		return 0, fmt.Errorf("no source range set ID available")
+	}
+	id := srcRangeSets.nextID
+	srcRangeSets.nextID++
+	return id, nil
+}
+
+// releaseSrcRangeSetLocked drops the reference of the given service to its
+// source range set, if any, removing the LPM entries of the set once the
+// last reference is gone.
+func releaseSrcRangeSetLocked(svcKey uint32, m *bpf.Map, ipv6 bool) error {
+	hash, ok := srcRangeSets.hashBySvc[svcKey]
+	if !ok {
+		return nil
+	}
+	delete(srcRangeSets.hashBySvc, svcKey)
+
+	set := srcRangeSets.byHash[hash]
+	set.refcount--
+	if set.refcount > 0 {
+		return nil
+	}
+	delete(srcRangeSets.byHash, hash)
+	for _, c := range set.ranges {
+		if err := m.Delete(srcRangeKey(c, set.id, ipv6)); err != nil {
+			return err
+		}
+	}
+	// The ID may only be released for reuse once none of its LPM entries
+	// remain, otherwise a later set reusing it inherits stale CIDRs.
+	srcRangeSets.freeIDs = append(srcRangeSets.freeIDs, set.id)
+	return nil
+}
+
 func srcRangeKey(cidr *cidr.CIDR, revNATID uint16, ipv6 bool) bpf.MapKey {
 	ones, _ := cidr.Mask.Size()
 	id := byteorder.HostToNetwork16(revNATID)
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package lbmap
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cilium/cilium/pkg/cidr"
+	datapathTypes "github.com/cilium/cilium/pkg/datapath/types"
+	"github.com/cilium/cilium/pkg/loadbalancer"
+	"github.com/cilium/cilium/pkg/lock"
+)
+
+// traceRingSize is the number of mutating operations kept in the trace ring
+// buffer of a TracingLBMap.
+const traceRingSize = 1024
+
+// traceOp is a single recorded mutation of the LB maps.
+type traceOp struct {
+	timestamp time.Time
+	op        string
+	detail    string
+	duration  time.Duration
+	err       error
+}
+
+func (t *traceOp) String() string {
+	result := "ok"
+	if t.err != nil {
+		result = t.err.Error()
+	}
+	return fmt.Sprintf("%s %s %s duration=%s result=%s",
+		t.timestamp.Format(time.RFC3339Nano), t.op, t.detail, t.duration, result)
+}
+
+// TracingLBMap decorates an LBMap implementation and records every mutating
+// operation with its key/value summary, latency and result into a fixed-size
+// ring buffer. The buffer is exposed through the debug API ("cilium-dbg
+// debuginfo") for post-mortem analysis of map corruption reports. Read-only
+// operations are passed through unrecorded.
+type TracingLBMap struct {
+	datapathTypes.LBMap
+
+	mutex lock.Mutex
+	ops   [traceRingSize]traceOp
+	// next is the index at which the next operation is recorded.
+	next int
+	// total is the number of operations recorded since creation.
+	total uint64
+}
+
+// NewTracingLBMap returns a tracing decorator around the given LBMap.
+func NewTracingLBMap(m datapathTypes.LBMap) *TracingLBMap {
+	return &TracingLBMap{LBMap: m}
+}
+
+func (t *TracingLBMap) record(op, detail string, start time.Time, err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.ops[t.next] = traceOp{
+		timestamp: start,
+		op:        op,
+		detail:    detail,
+		duration:  time.Since(start),
+		err:       err,
+	}
+	t.next = (t.next + 1) % traceRingSize
+	t.total++
+}
+
+func (t *TracingLBMap) UpsertService(p *datapathTypes.UpsertServiceParams) error {
+	start := time.Now()
+	err := t.LBMap.UpsertService(p)
+	t.record("UpsertService",
+		fmt.Sprintf("id=%d addr=%s:%d type=%s backends=%d prev-backends=%d",
+			p.ID, p.IP, p.Port, p.Type,
+			len(p.ActiveBackends)+len(p.NonActiveBackends), p.PrevBackendsCount),
+		start, err)
+	return err
+}
+
+func (t *TracingLBMap) UpsertMaglevLookupTable(svcID uint16, backends map[string]*loadbalancer.Backend, ipv6 bool) error {
+	start := time.Now()
+	err := t.LBMap.UpsertMaglevLookupTable(svcID, backends, ipv6)
+	t.record("UpsertMaglevLookupTable",
+		fmt.Sprintf("id=%d backends=%d ipv6=%t", svcID, len(backends), ipv6),
+		start, err)
+	return err
+}
+
+func (t *TracingLBMap) DeleteService(addr loadbalancer.L3n4AddrID, backendCount int, useMaglev bool, natPolicy loadbalancer.SVCNatPolicy) error {
+	start := time.Now()
+	err := t.LBMap.DeleteService(addr, backendCount, useMaglev, natPolicy)
+	t.record("DeleteService",
+		fmt.Sprintf("id=%d addr=%s backends=%d", addr.ID, addr.String(), backendCount),
+		start, err)
+	return err
+}
+
+func (t *TracingLBMap) AddBackend(b *loadbalancer.Backend, ipv6 bool) error {
+	start := time.Now()
+	err := t.LBMap.AddBackend(b, ipv6)
+	t.record("AddBackend",
+		fmt.Sprintf("id=%d addr=%s ipv6=%t", b.ID, b.String(), ipv6),
+		start, err)
+	return err
+}
+
+func (t *TracingLBMap) UpdateBackendWithState(b *loadbalancer.Backend) error {
+	start := time.Now()
+	err := t.LBMap.UpdateBackendWithState(b)
+	state, _ := b.State.String()
+	t.record("UpdateBackendWithState",
+		fmt.Sprintf("id=%d addr=%s state=%s", b.ID, b.String(), state),
+		start, err)
+	return err
+}
+
+func (t *TracingLBMap) DeleteBackendByID(id loadbalancer.BackendID) error {
+	start := time.Now()
+	err := t.LBMap.DeleteBackendByID(id)
+	t.record("DeleteBackendByID", fmt.Sprintf("id=%d", id), start, err)
+	return err
+}
+
+func (t *TracingLBMap) AddAffinityMatch(revNATID uint16, backendID loadbalancer.BackendID) error {
+	start := time.Now()
+	err := t.LBMap.AddAffinityMatch(revNATID, backendID)
+	t.record("AddAffinityMatch",
+		fmt.Sprintf("svc=%d backend=%d", revNATID, backendID), start, err)
+	return err
+}
+
+func (t *TracingLBMap) DeleteAffinityMatch(revNATID uint16, backendID loadbalancer.BackendID) error {
+	start := time.Now()
+	err := t.LBMap.DeleteAffinityMatch(revNATID, backendID)
+	t.record("DeleteAffinityMatch",
+		fmt.Sprintf("svc=%d backend=%d", revNATID, backendID), start, err)
+	return err
+}
+
+func (t *TracingLBMap) UpdateSourceRanges(revNATID uint16, ranges []*cidr.CIDR, ipv6 bool) (uint16, error) {
+	start := time.Now()
+	setID, err := t.LBMap.UpdateSourceRanges(revNATID, ranges, ipv6)
+	t.record("UpdateSourceRanges",
+		fmt.Sprintf("svc=%d ranges=%d set=%d ipv6=%t", revNATID, len(ranges), setID, ipv6),
+		start, err)
+	return setID, err
+}
+
+func (t *TracingLBMap) DeleteSourceRanges(id uint16, ranges []*cidr.CIDR, ipv6 bool) error {
+	start := time.Now()
+	err := t.LBMap.DeleteSourceRanges(id, ranges, ipv6)
+	t.record("DeleteSourceRanges",
+		fmt.Sprintf("id=%d ranges=%d ipv6=%t", id, len(ranges), ipv6),
+		start, err)
+	return err
+}
+
+// DebugStatus implements debug.StatusObject. It returns the recorded
+// operations from oldest to newest.
+func (t *TracingLBMap) DebugStatus() string {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d operations recorded, showing the last %d:\n",
+		t.total, min(int(t.total), traceRingSize))
+	idx := t.next
+	for i := 0; i < traceRingSize; i++ {
+		op := &t.ops[(idx+i)%traceRingSize]
+		if op.timestamp.IsZero() {
+			continue
+		}
+		sb.WriteString(op.String())
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
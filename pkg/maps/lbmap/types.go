@@ -80,6 +80,10 @@ type ServiceValue interface {
 	// Set timeout for sessionAffinity=clientIP
 	SetSessionAffinityTimeoutSec(t uint32)
 
+	// Set the shared source range set ID, zero when the source ranges are
+	// keyed by the reverse NAT identifier
+	SetSrcRangeSetID(id uint16)
+
 	// Set proxy port for l7 loadbalancer services
 	SetL7LBProxyPort(port uint16)
 
@@ -97,6 +97,33 @@ func GetCTCurTime(clockSource *models.ClockSource) (uint64, error) {
 
 type TimestampConverter func(timestamp uint64) uint64
 
+// Returns a function that converts a duration in seconds into the units used
+// for timestamps in CT and NAT maps, mirroring bpf_sec_to_mono in the
+// datapath.
+func NewSecToCTTimeConverter(clockSource *models.ClockSource) (TimestampConverter, error) {
+	if clockSource == nil {
+		return nil, fmt.Errorf("clockSource is nil")
+	}
+	switch clockSource.Mode {
+	case models.ClockSourceModeKtime:
+		converter := func(seconds uint64) uint64 {
+			return seconds
+		}
+		return converter, nil
+	case models.ClockSourceModeJiffies:
+		hertz := clockSource.Hertz
+		if hertz == 0 {
+			return nil, fmt.Errorf("invalid clock Hertz value (0)")
+		}
+		converter := func(seconds uint64) uint64 {
+			return (seconds * uint64(hertz)) >> bpfMonoScaler
+		}
+		return converter, nil
+	default:
+		return nil, fmt.Errorf("invalid clocksource: %s", clockSource.Mode)
+	}
+}
+
 // Returns a function that converts a CT timestamp from clocksource units into
 // seconds.
 func NewCTTimeToSecConverter(clockSource *models.ClockSource) (TimestampConverter, error) {
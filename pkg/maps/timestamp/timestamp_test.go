@@ -40,3 +40,33 @@ func TestConvert(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertSecToCTTime(t *testing.T) {
+	tests := []struct {
+		name  string
+		mode  string
+		hertz int64
+		err   bool
+		inp   uint64
+		res   uint64
+	}{
+		{name: "ktime", mode: models.ClockSourceModeKtime, inp: uint64(10800), res: uint64(10800)},
+		{name: "jiffies_err", mode: models.ClockSourceModeJiffies, err: true},
+		{name: "jiffies_ok", mode: models.ClockSourceModeJiffies, hertz: 1000, inp: uint64(10800), res: uint64(10800 * 1000 >> 8)},
+		{name: "invalid", mode: "", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clockSource := &models.ClockSource{Mode: tt.mode, Hertz: tt.hertz}
+			conv, err := NewSecToCTTimeConverter(clockSource)
+			if tt.err {
+				require.Error(t, err, "Invalid converter created")
+			} else {
+				require.NoError(t, err, "Failed to create converter")
+				res := conv(tt.inp)
+				require.Equal(t, tt.res, res)
+			}
+		})
+	}
+}
@@ -224,6 +224,12 @@ const (
 	// LabelTargetCluster is the label for target cluster name
 	LabelTargetCluster = "target_cluster"
 
+	// LabelResource is the label for the resource type
+	LabelResource = "resource"
+
+	// LabelCompression is the label for the compression algorithm
+	LabelCompression = "compression"
+
 	// LabelTargetNodeIP is the label for target node IP
 	LabelTargetNodeIP = "target_node_ip"
 
@@ -418,6 +424,15 @@ var (
 	// The metric reflects the time it took to program the service excluding the event queue latency.
 	ServiceImplementationDelay = NoOpObserverVec
 
+	// ServiceSockRevNatPruned counts the number of sock rev nat entries
+	// removed by garbage collection because their service no longer exists.
+	ServiceSockRevNatPruned = NoOpCounter
+
+	// ServiceAffinityPruned counts the number of session affinity entries
+	// removed by garbage collection because they expired or their service
+	// no longer exists.
+	ServiceAffinityPruned = NoOpCounter
+
 	// Errors and warnings
 
 	// ErrorsWarnings is the number of errors and warnings in cilium-agent instances
@@ -485,6 +500,22 @@ var (
 	// KVStoreQuorumErrors records the number of kvstore quorum errors
 	KVStoreQuorumErrors = NoOpCounterVec
 
+	// KVStoreInitialListPages records the number of pages retrieved during
+	// the paginated initial list of a kvstore prefix
+	KVStoreInitialListPages = NoOpCounterVec
+
+	// KVStoreEventsQueueDepth records the number of events pending in the
+	// queue of a kvstore watcher when a new event is received
+	KVStoreEventsQueueDepth = NoOpObserverVec
+
+	// KVStoreCertificateLifetime records the remaining lifetime of the TLS
+	// certificates used to connect to the kvstore
+	KVStoreCertificateLifetime = NoOpGaugeVec
+
+	// KVStoreFailoverActive records whether the client is currently
+	// connected to the failover etcd cluster rather than the primary one
+	KVStoreFailoverActive = NoOpGauge
+
 	// FQDNGarbageCollectorCleanedTotal is the number of domains cleaned by the
 	// GC job.
 	FQDNGarbageCollectorCleanedTotal = NoOpCounter
@@ -680,6 +711,8 @@ type LegacyMetrics struct {
 	SignalsHandled                   metric.Vec[metric.Counter]
 	ServicesEventsCount              metric.Vec[metric.Counter]
 	ServiceImplementationDelay       metric.Vec[metric.Observer]
+	ServiceSockRevNatPruned          metric.Counter
+	ServiceAffinityPruned            metric.Counter
 	ErrorsWarnings                   metric.Vec[metric.Counter]
 	ControllerRuns                   metric.Vec[metric.Counter]
 	ControllerRunsDuration           metric.Vec[metric.Observer]
@@ -696,6 +729,10 @@ type LegacyMetrics struct {
 	KVStoreOperationsDuration        metric.Vec[metric.Observer]
 	KVStoreEventsQueueDuration       metric.Vec[metric.Observer]
 	KVStoreQuorumErrors              metric.Vec[metric.Counter]
+	KVStoreInitialListPages          metric.Vec[metric.Counter]
+	KVStoreEventsQueueDepth          metric.Vec[metric.Observer]
+	KVStoreCertificateLifetime       metric.Vec[metric.Gauge]
+	KVStoreFailoverActive            metric.Gauge
 	FQDNGarbageCollectorCleanedTotal metric.Counter
 	FQDNActiveNames                  metric.Vec[metric.Gauge]
 	FQDNActiveIPs                    metric.Vec[metric.Gauge]
@@ -988,6 +1025,20 @@ func NewLegacyMetrics() *LegacyMetrics {
 			Help:       "Number of services events labeled by action type",
 		}, []string{LabelAction}),
 
+		ServiceSockRevNatPruned: metric.NewCounter(metric.CounterOpts{
+			ConfigName: Namespace + "_service_sock_rev_nat_pruned_total",
+			Namespace:  Namespace,
+			Name:       "service_sock_rev_nat_pruned_total",
+			Help:       "Number of sock rev nat entries removed by garbage collection because their service no longer exists",
+		}),
+
+		ServiceAffinityPruned: metric.NewCounter(metric.CounterOpts{
+			ConfigName: Namespace + "_service_affinity_pruned_total",
+			Namespace:  Namespace,
+			Name:       "service_affinity_pruned_total",
+			Help:       "Number of session affinity entries removed by garbage collection because they expired or their service no longer exists",
+		}),
+
 		ServiceImplementationDelay: metric.NewHistogramVec(metric.HistogramOpts{
 			ConfigName: Namespace + "_service_implementation_delay",
 			Namespace:  Namespace,
@@ -1113,6 +1164,39 @@ func NewLegacyMetrics() *LegacyMetrics {
 			Help:       "Number of quorum errors",
 		}, []string{LabelError}),
 
+		KVStoreInitialListPages: metric.NewCounterVec(metric.CounterOpts{
+			ConfigName: Namespace + "_" + SubsystemKVStore + "_initial_list_pages_total",
+			Namespace:  Namespace,
+			Subsystem:  SubsystemKVStore,
+			Name:       "initial_list_pages_total",
+			Help:       "Number of pages retrieved during the paginated initial list of a prefix",
+		}, []string{LabelScope, LabelOutcome}),
+
+		KVStoreEventsQueueDepth: metric.NewHistogramVec(metric.HistogramOpts{
+			ConfigName: Namespace + "_" + SubsystemKVStore + "_events_queue_depth",
+			Namespace:  Namespace,
+			Subsystem:  SubsystemKVStore,
+			Name:       "events_queue_depth",
+			Help:       "Number of events pending in the queue of a watcher when a new event is received",
+			Buckets:    []float64{1, 2, 4, 8, 16, 32, 64, 128, 256, 512, 1024},
+		}, []string{LabelScope}),
+
+		KVStoreCertificateLifetime: metric.NewGaugeVec(metric.GaugeOpts{
+			ConfigName: Namespace + "_" + SubsystemKVStore + "_certificate_remaining_seconds",
+			Namespace:  Namespace,
+			Subsystem:  SubsystemKVStore,
+			Name:       "certificate_remaining_seconds",
+			Help:       "Remaining lifetime in seconds of the TLS certificates used to connect to the kvstore",
+		}, []string{LabelType}),
+
+		KVStoreFailoverActive: metric.NewGauge(metric.GaugeOpts{
+			ConfigName: Namespace + "_" + SubsystemKVStore + "_failover_active",
+			Namespace:  Namespace,
+			Subsystem:  SubsystemKVStore,
+			Name:       "failover_active",
+			Help:       "Whether the client is connected to the failover etcd cluster (1) or the primary one (0)",
+		}),
+
 		IPCacheErrorsTotal: metric.NewCounterVec(metric.CounterOpts{
 			ConfigName: Namespace + "_" + SubsystemIPCache + "_errors_total",
 			Namespace:  Namespace,
@@ -1383,6 +1467,8 @@ func NewLegacyMetrics() *LegacyMetrics {
 	SignalsHandled = lm.SignalsHandled
 	ServicesEventsCount = lm.ServicesEventsCount
 	ServiceImplementationDelay = lm.ServiceImplementationDelay
+	ServiceSockRevNatPruned = lm.ServiceSockRevNatPruned
+	ServiceAffinityPruned = lm.ServiceAffinityPruned
 	ErrorsWarnings = lm.ErrorsWarnings
 	ControllerRuns = lm.ControllerRuns
 	ControllerRunsDuration = lm.ControllerRunsDuration
@@ -1399,6 +1485,10 @@ func NewLegacyMetrics() *LegacyMetrics {
 	KVStoreOperationsDuration = lm.KVStoreOperationsDuration
 	KVStoreEventsQueueDuration = lm.KVStoreEventsQueueDuration
 	KVStoreQuorumErrors = lm.KVStoreQuorumErrors
+	KVStoreInitialListPages = lm.KVStoreInitialListPages
+	KVStoreEventsQueueDepth = lm.KVStoreEventsQueueDepth
+	KVStoreCertificateLifetime = lm.KVStoreCertificateLifetime
+	KVStoreFailoverActive = lm.KVStoreFailoverActive
 	FQDNGarbageCollectorCleanedTotal = lm.FQDNGarbageCollectorCleanedTotal
 	FQDNActiveNames = lm.FQDNActiveNames
 	FQDNActiveIPs = lm.FQDNActiveIPs
@@ -231,6 +231,13 @@ const (
 	AgentNotifyIPCacheDeleted
 	AgentNotifyServiceUpserted
 	AgentNotifyServiceDeleted
+	AgentNotifyRouteUpserted
+	AgentNotifyRouteDeleted
+	AgentNotifyRuleUpserted
+	AgentNotifyRuleDeleted
+	AgentNotifyNeighborUpserted
+	AgentNotifyNeighborDeleted
+	AgentNotifyClusterMeshEvent
 )
 
 // AgentNotifications is a map of all supported agent notification types.
@@ -248,6 +255,13 @@ var AgentNotifications = map[AgentNotification]string{
 	AgentNotifyPolicyDeleted:             "Policy deleted",
 	AgentNotifyServiceDeleted:            "Service deleted",
 	AgentNotifyServiceUpserted:           "Service upserted",
+	AgentNotifyRouteUpserted:             "Route upserted",
+	AgentNotifyRouteDeleted:              "Route deleted",
+	AgentNotifyRuleUpserted:              "Rule upserted",
+	AgentNotifyRuleDeleted:               "Rule deleted",
+	AgentNotifyNeighborUpserted:          "Neighbor upserted",
+	AgentNotifyNeighborDeleted:           "Neighbor deleted",
+	AgentNotifyClusterMeshEvent:          "ClusterMesh event",
 }
 
 func resolveAgentType(t AgentNotification) string {
@@ -510,6 +524,90 @@ func ServiceDeleteMessage(id uint32) AgentNotifyMessage {
 	}
 }
 
+// DatapathUpdateNotification structures route, rule and neighbor programming
+// notifications
+type DatapathUpdateNotification struct {
+	// Target is the destination CIDR of a route, the selector of a rule or
+	// the IP address of a neighbor entry
+	Target string `json:"target"`
+	Device string `json:"device,omitempty"`
+	Table  int    `json:"table,omitempty"`
+	// Owner is the subsystem which requested the change
+	Owner  string `json:"owner,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+func datapathUpdateMessage(typ AgentNotification, target, device string, table int, owner, reason string) AgentNotifyMessage {
+	return AgentNotifyMessage{
+		Type: typ,
+		Notification: DatapathUpdateNotification{
+			Target: target,
+			Device: device,
+			Table:  table,
+			Owner:  owner,
+			Reason: reason,
+		},
+	}
+}
+
+// RouteUpsertedMessage constructs an agent notification message for the
+// installation of a node-local route
+func RouteUpsertedMessage(target, device string, table int, owner, reason string) AgentNotifyMessage {
+	return datapathUpdateMessage(AgentNotifyRouteUpserted, target, device, table, owner, reason)
+}
+
+// RouteDeletedMessage constructs an agent notification message for the
+// removal of a node-local route
+func RouteDeletedMessage(target, device string, table int, owner, reason string) AgentNotifyMessage {
+	return datapathUpdateMessage(AgentNotifyRouteDeleted, target, device, table, owner, reason)
+}
+
+// RuleUpsertedMessage constructs an agent notification message for the
+// installation of an IP rule
+func RuleUpsertedMessage(target string, table int, owner, reason string) AgentNotifyMessage {
+	return datapathUpdateMessage(AgentNotifyRuleUpserted, target, "", table, owner, reason)
+}
+
+// RuleDeletedMessage constructs an agent notification message for the
+// removal of an IP rule
+func RuleDeletedMessage(target string, table int, owner, reason string) AgentNotifyMessage {
+	return datapathUpdateMessage(AgentNotifyRuleDeleted, target, "", table, owner, reason)
+}
+
+// NeighborUpsertedMessage constructs an agent notification message for the
+// installation of an ARP/NDP neighbor entry
+func NeighborUpsertedMessage(target, device, owner, reason string) AgentNotifyMessage {
+	return datapathUpdateMessage(AgentNotifyNeighborUpserted, target, device, 0, owner, reason)
+}
+
+// NeighborDeletedMessage constructs an agent notification message for the
+// removal of an ARP/NDP neighbor entry
+func NeighborDeletedMessage(target, device, owner, reason string) AgentNotifyMessage {
+	return datapathUpdateMessage(AgentNotifyNeighborDeleted, target, device, 0, owner, reason)
+}
+
+// ClusterMeshNotification structures a remote cluster lifecycle notification
+type ClusterMeshNotification struct {
+	Cluster string `json:"cluster"`
+	Event   string `json:"event"`
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterMeshEventMessage constructs an agent notification message for a
+// remote cluster lifecycle event
+func ClusterMeshEventMessage(cluster, event, message string) AgentNotifyMessage {
+	notification := ClusterMeshNotification{
+		Cluster: cluster,
+		Event:   event,
+		Message: message,
+	}
+
+	return AgentNotifyMessage{
+		Type:         AgentNotifyClusterMeshEvent,
+		Notification: notification,
+	}
+}
+
 const (
 	// PolicyIngress is the value of Flags&PolicyNotifyFlagDirection for ingress traffic
 	PolicyIngress = 1
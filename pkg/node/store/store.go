@@ -101,6 +101,18 @@ func ClusterIDValidator(clusterID *uint32) nodeValidator {
 	}
 }
 
+// EncryptionValidator returns a validator refusing nodes that do not
+// advertise transparent encryption support (either an IPsec key index or a
+// WireGuard public key), when enforcement is enabled.
+func EncryptionValidator(enforce bool) nodeValidator {
+	return func(_ string, n *nodeTypes.Node) error {
+		if enforce && n.EncryptionKey == 0 && n.WireguardPubKey == "" {
+			return fmt.Errorf("node does not advertise transparent encryption support")
+		}
+		return nil
+	}
+}
+
 // ValidatingKeyCreator returns a store.KeyCreator for Nodes, configuring the
 // specified extra validators.
 func ValidatingKeyCreator(validators ...nodeValidator) store.KeyCreator {
@@ -113,6 +113,23 @@ type SelectorCache struct {
 	startNotificationsHandlerOnce sync.Once
 }
 
+// MatchesAnySelector returns whether the given identity is selected by at
+// least one of the currently cached selectors.
+func (sc *SelectorCache) MatchesAnySelector(nid identity.NumericIdentity, lbls labels.LabelArray) bool {
+	id := newIdentity(nid, lbls)
+
+	sc.mutex.RLock()
+	defer sc.mutex.RUnlock()
+
+	for _, sel := range sc.selectors {
+		if sel.source.matches(id) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // GetModel returns the API model of the SelectorCache.
 func (sc *SelectorCache) GetModel() models.SelectorCache {
 	sc.mutex.RLock()
@@ -19,6 +19,9 @@ var Cell = cell.Module(
 
 	cell.ProvidePrivate(func(sm ServiceManager) syncNodePort { return sm }),
 	cell.Invoke(registerServiceReconciler),
+	cell.Invoke(registerSockRevNatGC),
+	cell.Invoke(registerAffinityGC),
+	cell.Invoke(registerBackendHealthScan),
 )
 
 type serviceManagerParams struct {
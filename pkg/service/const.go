@@ -8,8 +8,10 @@ const (
 	FirstFreeServiceID = uint32(1)
 
 	// MaxSetOfServiceID is maximum number of set of service IDs that can be stored
-	// in the kvstore or the local ID allocator.
-	MaxSetOfServiceID = uint32(0xFFFF)
+	// in the kvstore or the local ID allocator. The IDs above this boundary are
+	// reserved for shared source range sets, see
+	// pkg/maps/lbmap.SourceRangeSetIDFirst.
+	MaxSetOfServiceID = uint32(0xEFFF)
 
 	// FirstFreeBackendID is the first ID for which the backend should be assigned.
 	// BPF datapath assumes that backend_id cannot be 0.
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package service
+
+import (
+	"context"
+
+	"github.com/cilium/hive/cell"
+	"github.com/cilium/hive/job"
+
+	"github.com/cilium/cilium/pkg/option"
+	"github.com/cilium/cilium/pkg/time"
+)
+
+// sockRevNatGCInterval is the interval in which sock rev nat entries
+// referring to no longer existing services are pruned.
+const sockRevNatGCInterval = 5 * time.Minute
+
+// affinityGCInterval is the interval in which expired session affinity
+// entries are pruned.
+const affinityGCInterval = 5 * time.Minute
+
+// backendHealthScanInterval is the interval in which the TCP conntrack maps
+// are scanned to update the passive health score of backends.
+const backendHealthScanInterval = 30 * time.Second
+
+type sockRevNatGCParams struct {
+	cell.In
+
+	Lifecycle      cell.Lifecycle
+	Jobs           job.Registry
+	Health         cell.Health
+	ServiceManager ServiceManager
+}
+
+// registerSockRevNatGC registers a periodic job pruning sock rev nat entries
+// whose service no longer exists. Without it, entries left behind by heavy
+// service churn are only reclaimed through LRU eviction once the map is
+// full.
+func registerSockRevNatGC(p sockRevNatGCParams) {
+	if !option.Config.EnableSocketLB {
+		return
+	}
+
+	g := p.Jobs.NewGroup(p.Health)
+	g.Add(job.Timer("sock-rev-nat-gc", func(ctx context.Context) error {
+		return p.ServiceManager.PruneSockRevNat()
+	}, sockRevNatGCInterval))
+	p.Lifecycle.Append(g)
+}
+
+// registerAffinityGC registers a periodic job pruning session affinity
+// entries which expired or whose service no longer exists. The datapath
+// only drops an expired entry when the client sends further traffic, so
+// entries of idle clients would otherwise linger until evicted by the LRU
+// logic of the map.
+func registerAffinityGC(p sockRevNatGCParams) {
+	if !option.Config.EnableSessionAffinity {
+		return
+	}
+
+	g := p.Jobs.NewGroup(p.Health)
+	g.Add(job.Timer("session-affinity-gc", func(ctx context.Context) error {
+		return p.ServiceManager.PruneSessionAffinity()
+	}, affinityGCInterval))
+	p.Lifecycle.Append(g)
+}
+
+// registerBackendHealthScan registers a periodic job scanning the TCP
+// conntrack maps to passively score the health of backends. Services opt into
+// the scoring individually via annotation, hence no global gate here.
+func registerBackendHealthScan(p sockRevNatGCParams) {
+	g := p.Jobs.NewGroup(p.Health)
+	g.Add(job.Timer("backend-health-scan", func(ctx context.Context) error {
+		return p.ServiceManager.ScanBackendHealth()
+	}, backendHealthScanInterval))
+	p.Lifecycle.Append(g)
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package service
+
+import (
+	lb "github.com/cilium/cilium/pkg/loadbalancer"
+	"github.com/cilium/cilium/pkg/logging/logfields"
+	"github.com/cilium/cilium/pkg/maps/ctmap"
+	"github.com/cilium/cilium/pkg/option"
+)
+
+const (
+	// healthScoreMinSamples is the minimum number of conntrack entries which
+	// must reference a backend before its health score is acted upon.
+	healthScoreMinSamples = 10
+
+	// healthScoreEWMAWeight is the weight given to the most recent scan when
+	// updating the exponentially weighted moving average of a backend's
+	// failure rate.
+	healthScoreEWMAWeight = 0.5
+
+	// healthScoreDemoteThreshold is the score above which an active backend
+	// is quarantined. Together with healthScoreRestoreThreshold it forms the
+	// hysteresis preventing backends from flapping between states.
+	healthScoreDemoteThreshold = 0.5
+
+	// healthScoreRestoreThreshold is the score below which a backend
+	// previously quarantined by the scorer is restored to active.
+	healthScoreRestoreThreshold = 0.1
+)
+
+// ScanBackendHealth dumps the TCP conntrack maps and updates the passive
+// health score of all backends belonging to services which opted into passive
+// health scoring. Backends whose score crossed the demote threshold are
+// quarantined, and backends previously quarantined by the scorer are restored
+// once their score recovered.
+func (s *Service) ScanBackendHealth() error {
+	stats, err := ctmap.ScanTCPBackendStats(option.Config.EnableIPv4, option.Config.EnableIPv6)
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+
+	var updates []*lb.Backend
+	seen := make(map[lb.BackendID]struct{})
+	for _, svc := range s.svcByHash {
+		if !svc.passiveHealth {
+			continue
+		}
+		for _, b := range svc.backends {
+			if _, ok := seen[b.ID]; ok {
+				continue
+			}
+			seen[b.ID] = struct{}{}
+
+			score, samples := s.updateHealthScore(b.ID, stats[uint64(b.ID)])
+			_, quarantinedByScorer := s.healthQuarantined[b.ID]
+
+			switch {
+			case b.State == lb.BackendStateActive &&
+				samples >= healthScoreMinSamples &&
+				score >= healthScoreDemoteThreshold:
+				demoted := b.DeepCopy()
+				demoted.State = lb.BackendStateQuarantined
+				updates = append(updates, demoted)
+				s.healthQuarantined[b.ID] = struct{}{}
+				log.WithField(logfields.BackendID, b.ID).
+					WithField(logfields.ServiceName, svc.svcName).
+					Warningf("Quarantining backend due to passive health score %.2f", score)
+			case b.State == lb.BackendStateQuarantined &&
+				quarantinedByScorer &&
+				score <= healthScoreRestoreThreshold:
+				restored := b.DeepCopy()
+				restored.State = lb.BackendStateActive
+				updates = append(updates, restored)
+				delete(s.healthQuarantined, b.ID)
+				log.WithField(logfields.BackendID, b.ID).
+					WithField(logfields.ServiceName, svc.svcName).
+					Infof("Restoring backend with recovered passive health score %.2f", score)
+			}
+		}
+	}
+
+	// Drop the state of backends which are gone or whose service no longer
+	// opts into passive health scoring.
+	for id := range s.healthScores {
+		if _, ok := seen[id]; !ok {
+			delete(s.healthScores, id)
+			delete(s.healthQuarantined, id)
+		}
+	}
+
+	s.Unlock()
+
+	if len(updates) == 0 {
+		return nil
+	}
+	return s.UpdateBackendsState(updates)
+}
+
+// updateHealthScore folds the stats of the latest conntrack scan into the
+// backend's health score and returns the updated score along with the number
+// of samples the scan was based on. Failed connection attempts count as full
+// failures, established connections with stale replies as half ones.
+func (s *Service) updateHealthScore(id lb.BackendID, st *ctmap.BackendTCPStats) (float64, uint64) {
+	var badness float64
+	var samples uint64
+
+	if st != nil {
+		samples = st.Established + st.FailedConnects
+		if samples > 0 {
+			badness = (float64(st.FailedConnects) + float64(st.StaleReplies)/2) / float64(samples)
+		}
+	}
+
+	score := s.healthScores[id]*(1-healthScoreEWMAWeight) + badness*healthScoreEWMAWeight
+	s.healthScores[id] = score
+	return score, samples
+}
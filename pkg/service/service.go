@@ -84,6 +84,7 @@ type svcInfo struct {
 	svcNatPolicy              lb.SVCNatPolicy
 	sessionAffinity           bool
 	sessionAffinityTimeoutSec uint32
+	passiveHealth             bool
 	svcHealthCheckNodePort    uint16
 	healthcheckFrontendHash   string
 	svcName                   lb.ServiceName
@@ -269,6 +270,13 @@ type Service struct {
 	backendConnectionHandler sockets.SocketDestroyer
 
 	backendDiscovery datapathTypes.NodeNeighbors
+
+	// healthScores tracks the passive health score per backend, see
+	// healthscore.go.
+	healthScores map[lb.BackendID]float64
+	// healthQuarantined tracks the backends quarantined by the passive
+	// health scorer, so that only those are restored by it.
+	healthQuarantined map[lb.BackendID]struct{}
 }
 
 // NewService creates a new instance of the service handler.
@@ -289,6 +297,8 @@ func NewService(monitorAgent monitorAgent.Agent, lbmap datapathTypes.LBMap, back
 		l7lbSvcs:                 map[lb.ServiceName]*L7LBInfo{},
 		backendConnectionHandler: backendConnectionHandler{},
 		backendDiscovery:         backendDiscoveryHandler,
+		healthScores:             map[lb.BackendID]float64{},
+		healthQuarantined:        map[lb.BackendID]struct{}{},
 	}
 	svc.lastUpdatedTs.Store(time.Now())
 
@@ -504,93 +514,6 @@ func (s *Service) GetCurrentTs() time.Time {
 	return time.Now()
 }
 
-func (s *Service) populateBackendMapV3FromV2(ipv4, ipv6 bool) error {
-	const (
-		v4 = "ipv4"
-		v6 = "ipv6"
-	)
-
-	enabled := map[string]bool{v4: ipv4, v6: ipv6}
-
-	for v, e := range enabled {
-		if !e {
-			continue
-		}
-
-		var (
-			err          error
-			v2Map        *bpf.Map
-			v3Map        *bpf.Map
-			v3BackendVal lbmap.BackendValue
-		)
-
-		copyBackendEntries := func(key bpf.MapKey, value bpf.MapValue) {
-			if v == v4 {
-				v3Map = lbmap.Backend4MapV3
-				v1BackendVal := value.(*lbmap.Backend4Value)
-				addrCluster := cmtypes.AddrClusterFrom(v1BackendVal.Address.Addr(), 0)
-				v3BackendVal, err = lbmap.NewBackend4ValueV3(
-					addrCluster,
-					v1BackendVal.Port,
-					v1BackendVal.Proto,
-					lb.GetBackendStateFromFlags(v1BackendVal.Flags),
-					0,
-				)
-				if err != nil {
-					log.WithError(err).WithField(logfields.BPFMapName, v3Map.Name()).Debug("Error creating map value")
-					return
-				}
-			} else {
-				v3Map = lbmap.Backend6MapV3
-				v1BackendVal := value.(*lbmap.Backend6Value)
-				addrCluster := cmtypes.AddrClusterFrom(v1BackendVal.Address.Addr(), 0)
-				v3BackendVal, err = lbmap.NewBackend6ValueV3(
-					addrCluster,
-					v1BackendVal.Port,
-					v1BackendVal.Proto,
-					lb.GetBackendStateFromFlags(v1BackendVal.Flags),
-					0,
-				)
-				if err != nil {
-					log.WithError(err).WithField(logfields.BPFMapName, v3Map.Name()).Debug("Error creating map value")
-					return
-				}
-			}
-
-			err := v3Map.Update(key, v3BackendVal)
-			if err != nil {
-				log.WithError(err).WithField(logfields.BPFMapName, v3Map.Name()).Warn("Error updating map")
-			}
-		}
-
-		if v == v4 {
-			v2Map = lbmap.Backend4MapV2
-		} else {
-			v2Map = lbmap.Backend6MapV2
-		}
-
-		err = v2Map.DumpWithCallback(copyBackendEntries)
-		if err != nil {
-			return fmt.Errorf("unable to populate %s: %w", v2Map.Name(), err)
-		}
-
-		// V2 backend map will be removed from bpffs at this point,
-		// the map will be actually removed once the last program
-		// referencing it has been removed.
-		err = v2Map.Close()
-		if err != nil {
-			log.WithError(err).WithField(logfields.BPFMapName, v2Map.Name()).Warn("Error closing map")
-		}
-
-		err = v2Map.Unpin()
-		if err != nil {
-			log.WithError(err).WithField(logfields.BPFMapName, v2Map.Name()).Warn("Error unpinning map")
-		}
-
-	}
-	return nil
-}
-
 // InitMaps opens or creates BPF maps used by services.
 //
 // If restore is set to false, entries of the maps are removed.
@@ -598,36 +521,29 @@ func (s *Service) InitMaps(ipv6, ipv4, sockMaps, restore bool) error {
 	s.Lock()
 	defer s.Unlock()
 
-	var (
-		v2BackendMapExistsV4 bool
-		v2BackendMapExistsV6 bool
-	)
-
 	toOpen := []*bpf.Map{}
 	toDelete := []*bpf.Map{}
 	if ipv6 {
-		toOpen = append(toOpen, lbmap.Service6MapV2, lbmap.Backend6MapV3, lbmap.RevNat6Map)
+		toOpen = append(toOpen, lbmap.Service6MapV2, lbmap.Backend6MapV3, lbmap.RevNat6MapV2)
 		if !restore {
-			toDelete = append(toDelete, lbmap.Service6MapV2, lbmap.Backend6MapV3, lbmap.RevNat6Map)
+			toDelete = append(toDelete, lbmap.Service6MapV2, lbmap.Backend6MapV3, lbmap.RevNat6MapV2)
 		}
 		if sockMaps {
 			if err := lbmap.CreateSockRevNat6Map(); err != nil {
 				return err
 			}
 		}
-		v2BackendMapExistsV6 = lbmap.Backend6MapV2.Open() == nil
 	}
 	if ipv4 {
-		toOpen = append(toOpen, lbmap.Service4MapV2, lbmap.Backend4MapV3, lbmap.RevNat4Map)
+		toOpen = append(toOpen, lbmap.Service4MapV2, lbmap.Backend4MapV3, lbmap.RevNat4MapV2)
 		if !restore {
-			toDelete = append(toDelete, lbmap.Service4MapV2, lbmap.Backend4MapV3, lbmap.RevNat4Map)
+			toDelete = append(toDelete, lbmap.Service4MapV2, lbmap.Backend4MapV3, lbmap.RevNat4MapV2)
 		}
 		if sockMaps {
 			if err := lbmap.CreateSockRevNat4Map(); err != nil {
 				return err
 			}
 		}
-		v2BackendMapExistsV4 = lbmap.Backend4MapV2.Open() == nil
 	}
 
 	for _, m := range toOpen {
@@ -641,16 +557,51 @@ func (s *Service) InitMaps(ipv6, ipv4, sockMaps, restore bool) error {
 		}
 	}
 
-	if v2BackendMapExistsV4 || v2BackendMapExistsV6 {
-		log.Info("Backend map v2 exists. Migrating entries to backend map v3.")
-		if err := s.populateBackendMapV3FromV2(v2BackendMapExistsV4, v2BackendMapExistsV6); err != nil {
-			log.WithError(err).Warn("Error populating V3 map from V2 map, might interrupt existing connections during upgrade")
-		}
+	if err := lbmap.MigrateMaps(); err != nil {
+		log.WithError(err).Warn("Error migrating LB maps to the current layout, might interrupt existing connections during upgrade")
 	}
 
 	return nil
 }
 
+// PruneSockRevNat removes sock rev nat entries which no longer have a
+// corresponding service.
+func (s *Service) PruneSockRevNat() error {
+	s.RLock()
+	knownIDs := make(map[uint16]struct{}, len(s.svcByID))
+	for id := range s.svcByID {
+		knownIDs[uint16(id)] = struct{}{}
+	}
+	s.RUnlock()
+
+	pruned, err := s.lbmap.PruneSockRevNat(knownIDs)
+	if pruned > 0 {
+		log.WithField(logfields.Count, pruned).Debug("Pruned sock rev nat entries of removed services")
+		metrics.ServiceSockRevNatPruned.Add(float64(pruned))
+	}
+	return err
+}
+
+// PruneSessionAffinity removes session affinity entries which expired or
+// whose service no longer exists.
+func (s *Service) PruneSessionAffinity() error {
+	s.RLock()
+	timeouts := make(map[uint16]uint32)
+	for id, svc := range s.svcByID {
+		if svc.sessionAffinity {
+			timeouts[uint16(id)] = svc.sessionAffinityTimeoutSec
+		}
+	}
+	s.RUnlock()
+
+	pruned, err := s.lbmap.PruneSessionAffinity(timeouts)
+	if pruned > 0 {
+		log.WithField(logfields.Count, pruned).Debug("Pruned expired session affinity entries")
+		metrics.ServiceAffinityPruned.Add(float64(pruned))
+	}
+	return err
+}
+
 // UpsertService inserts or updates the given service.
 //
 // The first return value is true if the service hasn't existed before.
@@ -1245,11 +1196,25 @@ func (s *Service) restoreAndDeleteOrphanSourceRanges() error {
 		if err != nil {
 			return err
 		}
+
+		// Entries keyed by a shared source range set ID must not be treated
+		// as orphans: restored services still reference the set IDs from
+		// their service map values. Restore the sets instead; sets left
+		// unclaimed are pruned once the sync with k8s has finished.
+		sets := datapathTypes.SourceRangeSetByServiceID{}
+		for id, srcRanges := range srcRangesBySvcID {
+			if id >= lbmap.SourceRangeSetIDFirst {
+				sets[id] = srcRanges
+				delete(srcRangesBySvcID, id)
+			}
+		}
+		s.lbmap.RestoreSourceRangeSets(sets, ipv6)
+
 		for svcID, srcRanges := range srcRangesBySvcID {
 			svc, found := s.svcByID[lb.ID(svcID)]
 			if !found {
-				// Delete ranges
-				if err := s.lbmap.UpdateSourceRanges(svcID, srcRanges, nil, ipv6); err != nil {
+				// Delete ranges of no longer existing services
+				if err := s.lbmap.DeleteSourceRanges(svcID, srcRanges, ipv6); err != nil {
 					return err
 				}
 			} else {
@@ -1327,6 +1292,20 @@ func (s *Service) SyncWithK8sFinished(localOnly bool, localServices sets.Set[k8s
 		}
 	}
 
+	// Remove restored source range sets which no service claimed during the sync
+	if option.Config.EnableSVCSourceRangeCheck {
+		if option.Config.EnableIPv4 {
+			if err := s.lbmap.PruneSourceRangeSets(false); err != nil {
+				return stale, err
+			}
+		}
+		if option.Config.EnableIPv6 {
+			if err := s.lbmap.PruneSourceRangeSets(true); err != nil {
+				return stale, err
+			}
+		}
+	}
+
 	// Remove obsolete backends and release their IDs
 	if err := s.deleteOrphanBackends(); err != nil {
 		log.WithError(err).Warn("Failed to remove orphan backends")
@@ -1363,6 +1342,7 @@ func (s *Service) createSVCInfoIfNotExist(p *lb.SVC) (*svcInfo, bool, bool,
 
 			sessionAffinity:           p.SessionAffinity,
 			sessionAffinityTimeoutSec: p.SessionAffinityTimeoutSec,
+			passiveHealth:             p.PassiveHealth,
 
 			svcExtTrafficPolicy:      p.ExtTrafficPolicy,
 			svcIntTrafficPolicy:      p.IntTrafficPolicy,
@@ -1399,6 +1379,7 @@ func (s *Service) createSVCInfoIfNotExist(p *lb.SVC) (*svcInfo, bool, bool,
 		svc.svcHealthCheckNodePort = p.HealthCheckNodePort
 		svc.sessionAffinity = p.SessionAffinity
 		svc.sessionAffinityTimeoutSec = p.SessionAffinityTimeoutSec
+		svc.passiveHealth = p.PassiveHealth
 		svc.loadBalancerSourceRanges = p.LoadBalancerSourceRanges
 		// Name, namespace and cluster are optional and intended for exposure via
 		// API. They they are not part of any BPF maps and cannot be restored
@@ -1500,10 +1481,12 @@ func (s *Service) upsertServiceIntoLBMaps(svc *svcInfo, isExtLocal, isIntLocal b
 	}
 
 	// Update LB source range check cidrs
+	var srcRangeSetID uint16
 	if checkLBSrcRange = svc.checkLBSourceRange() || len(prevLoadBalancerSourceRanges) != 0; checkLBSrcRange {
-		if err := s.lbmap.UpdateSourceRanges(uint16(svc.frontend.ID),
-			prevLoadBalancerSourceRanges, svc.loadBalancerSourceRanges,
-			v6FE); err != nil {
+		var err error
+		srcRangeSetID, err = s.lbmap.UpdateSourceRanges(uint16(svc.frontend.ID),
+			svc.loadBalancerSourceRanges, v6FE)
+		if err != nil {
 			return err
 		}
 	}
@@ -1572,6 +1555,7 @@ func (s *Service) upsertServiceIntoLBMaps(svc *svcInfo, isExtLocal, isIntLocal b
 		SessionAffinity:           svc.sessionAffinity,
 		SessionAffinityTimeoutSec: svc.sessionAffinityTimeoutSec,
 		CheckSourceRange:          checkLBSrcRange,
+		SourceRangeSetID:          srcRangeSetID,
 		UseMaglev:                 svc.useMaglev(),
 		L7LBProxyPort:             svc.l7LBProxyPort,
 		Name:                      svc.svcName,
@@ -1842,8 +1826,8 @@ func (s *Service) deleteServiceLocked(svc *svcInfo) error {
 
 	if option.Config.EnableSVCSourceRangeCheck &&
 		svc.svcType == lb.SVCTypeLoadBalancer {
-		if err := s.lbmap.UpdateSourceRanges(uint16(svc.frontend.ID),
-			svc.loadBalancerSourceRanges, nil, ipv6); err != nil {
+		if _, err := s.lbmap.UpdateSourceRanges(uint16(svc.frontend.ID),
+			nil, ipv6); err != nil {
 			return err
 		}
 	}
@@ -70,6 +70,12 @@ type ClusterService struct {
 	// Hostnames is map indexed by the backend IP address
 	Hostnames map[string]string `json:"hostnames"`
 
+	// BackendHints is a map indexed by the backend IP address, carrying the
+	// EndpointSlice-style topology information and conditions associated with
+	// each backend. Backends without a corresponding entry are considered
+	// ready, and without topology information.
+	BackendHints map[string]*BackendHint `json:"backendHints,omitempty"`
+
 	// Labels are the labels of the service
 	Labels map[string]string `json:"labels"`
 
@@ -87,6 +93,24 @@ type ClusterService struct {
 	ClusterID uint32 `json:"clusterID"`
 }
 
+// BackendHint is the EndpointSlice-style topology information and conditions
+// associated with a given backend.
+//
+// WARNING - STABLE API: Any change to this structure must be done in a
+// backwards compatible way.
+//
+// +k8s:deepcopy-gen=true
+type BackendHint struct {
+	// Zone is the topology zone the backend belongs to
+	Zone string `json:"zone,omitempty"`
+
+	// ForZones is the list of topology zones the backend is hinted for
+	ForZones []string `json:"forZones,omitempty"`
+
+	// Terminating is true when the backend is in terminating state
+	Terminating bool `json:"terminating,omitempty"`
+}
+
 func (s *ClusterService) String() string {
 	return s.Cluster + "/" + s.Namespace + "/" + s.Name
 }
@@ -12,6 +12,27 @@ import (
 	loadbalancer "github.com/cilium/cilium/pkg/loadbalancer"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendHint) DeepCopyInto(out *BackendHint) {
+	*out = *in
+	if in.ForZones != nil {
+		in, out := &in.ForZones, &out.ForZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendHint.
+func (in *BackendHint) DeepCopy() *BackendHint {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendHint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterService) DeepCopyInto(out *ClusterService) {
 	*out = *in
@@ -72,6 +93,21 @@ func (in *ClusterService) DeepCopyInto(out *ClusterService) {
 			(*out)[key] = val
 		}
 	}
+	if in.BackendHints != nil {
+		in, out := &in.BackendHints, &out.BackendHints
+		*out = make(map[string]*BackendHint, len(*in))
+		for key, val := range *in {
+			var outVal *BackendHint
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(BackendHint)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
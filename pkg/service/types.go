@@ -59,6 +59,19 @@ type ServiceManager interface {
 	// DeregisterL7LBServiceBackendSync deregisters a backend sync registration for the service.
 	DeregisterL7LBServiceBackendSync(serviceName lb.ServiceName, backendSyncRegistration BackendSyncer) error
 
+	// PruneSockRevNat removes sock rev nat entries which no longer have a
+	// corresponding service.
+	PruneSockRevNat() error
+
+	// PruneSessionAffinity removes session affinity entries which expired or
+	// whose service no longer exists.
+	PruneSessionAffinity() error
+
+	// ScanBackendHealth updates the passive health score of the backends of
+	// services which opted into passive health scoring, quarantining and
+	// restoring backends as their score crosses the thresholds.
+	ScanBackendHealth() error
+
 	// RestoreServices restores services from BPF maps.
 	RestoreServices() error
 
@@ -58,6 +58,7 @@ func (e *TestEndpoint) RequireEgressProg() bool                     { return fal
 func (e *TestEndpoint) RequireRouting() bool                        { return false }
 func (e *TestEndpoint) RequireEndpointRoute() bool                  { return false }
 func (e *TestEndpoint) GetPolicyVerdictLogFilter() uint32           { return 0xffff }
+func (e *TestEndpoint) GetDSCPEgress() uint8                        { return 0 }
 func (e *TestEndpoint) GetCIDRPrefixLengths() ([]int, []int)        { return nil, nil }
 func (e *TestEndpoint) GetID() uint64                               { return e.Id }
 func (e *TestEndpoint) StringID() string                            { return "42" }
@@ -218,16 +218,16 @@ func (m *LBMockMap) DumpAffinityMatches() (datapathTypes.BackendIDByServiceIDSet
 	return m.AffinityMatch, nil
 }
 
-func (m *LBMockMap) UpdateSourceRanges(revNATID uint16, prevRanges []*cidr.CIDR,
-	ranges []*cidr.CIDR, ipv6 bool) error {
+// UpdateSourceRanges updates the source ranges of the given service. The mock
+// map does not share source range sets between services; the ranges are kept
+// per service and the service's own ID is returned as set ID.
+func (m *LBMockMap) UpdateSourceRanges(revNATID uint16, ranges []*cidr.CIDR, ipv6 bool) (uint16, error) {
 	m.Lock()
 	defer m.Unlock()
 
-	if len(prevRanges) == 0 {
-		m.SourceRanges[revNATID] = []*cidr.CIDR{}
-	}
-	if len(prevRanges) != len(m.SourceRanges[revNATID]) {
-		return fmt.Errorf("Inconsistent view of source ranges")
+	if len(ranges) == 0 {
+		delete(m.SourceRanges, revNATID)
+		return 0, nil
 	}
 	srcRanges := []*cidr.CIDR{}
 	for _, cidr := range ranges {
@@ -238,6 +238,15 @@ func (m *LBMockMap) UpdateSourceRanges(revNATID uint16, prevRanges []*cidr.CIDR,
 	}
 	m.SourceRanges[revNATID] = srcRanges
 
+	return revNATID, nil
+}
+
+func (m *LBMockMap) DeleteSourceRanges(id uint16, ranges []*cidr.CIDR, ipv6 bool) error {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.SourceRanges, id)
+
 	return nil
 }
 
@@ -245,6 +254,17 @@ func (m *LBMockMap) DumpSourceRanges(ipv6 bool) (datapathTypes.SourceRangeSetByS
 	return m.SourceRanges, nil
 }
 
+// RestoreSourceRangeSets is a no-op as the mock map does not share source
+// range sets between services.
+func (m *LBMockMap) RestoreSourceRangeSets(sets datapathTypes.SourceRangeSetByServiceID, ipv6 bool) {
+}
+
+// PruneSourceRangeSets is a no-op as the mock map does not share source
+// range sets between services.
+func (m *LBMockMap) PruneSourceRangeSets(ipv6 bool) error {
+	return nil
+}
+
 func (m *LBMockMap) ExistsSockRevNat(cookie uint64, addr net.IP, port uint16) bool {
 	if addr.To4() != nil {
 		key := lbmap.NewSockRevNat4Key(cookie, addr, port)
@@ -260,3 +280,19 @@ func (m *LBMockMap) ExistsSockRevNat(cookie uint64, addr net.IP, port uint16) bo
 
 	return false
 }
+
+func (m *LBMockMap) PruneSockRevNat(knownRevNatIDs map[uint16]struct{}) (int, error) {
+	pruned := 0
+	for key, value := range m.SockRevNat4 {
+		if _, ok := knownRevNatIDs[value.RevNatIndex]; !ok {
+			delete(m.SockRevNat4, key)
+			pruned++
+		}
+	}
+	return pruned, nil
+}
+
+func (m *LBMockMap) PruneSessionAffinity(timeouts map[uint16]uint32) (int, error) {
+	// The mock map does not track affinity entries.
+	return 0, nil
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package mockmaps
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cilium/cilium/pkg/cidr"
+	datapathTypes "github.com/cilium/cilium/pkg/datapath/types"
+	lb "github.com/cilium/cilium/pkg/loadbalancer"
+)
+
+// ScaleLBMockMap wraps an LBMockMap and simulates the syscall latency and
+// capacity limits of the real BPF LB maps. It allows the service reconciler
+// to be scale- and soak-tested without privileged BPF access: OpLatency is
+// slept before every mutating operation, and upserts beyond the configured
+// map sizes fail the same way a full BPF map would. FaultHook, when set, is
+// consulted before every mutating operation and can inject arbitrary errors
+// deterministically.
+type ScaleLBMockMap struct {
+	*LBMockMap
+
+	// OpLatency is the simulated duration of a single map syscall. Zero
+	// disables the delay.
+	OpLatency time.Duration
+
+	// MaxServiceEntries is the maximum number of service frontends. Zero
+	// means unlimited.
+	MaxServiceEntries int
+
+	// MaxBackendEntries is the maximum number of backend entries. Zero
+	// means unlimited.
+	MaxBackendEntries int
+
+	// FaultHook, if non-nil, is called with the operation name before each
+	// mutating operation. A non-nil return value is returned to the caller
+	// without applying the operation.
+	FaultHook func(op string) error
+}
+
+// NewScaleLBMockMap returns a ScaleLBMockMap around a fresh LBMockMap with
+// no latency, no capacity limits and no fault injection configured.
+func NewScaleLBMockMap() *ScaleLBMockMap {
+	return &ScaleLBMockMap{LBMockMap: NewLBMockMap()}
+}
+
+// enter simulates the syscall latency and consults the fault hook. It must be
+// called without the LBMockMap lock held.
+func (s *ScaleLBMockMap) enter(op string) error {
+	if s.OpLatency > 0 {
+		time.Sleep(s.OpLatency)
+	}
+	if s.FaultHook != nil {
+		return s.FaultHook(op)
+	}
+	return nil
+}
+
+func (s *ScaleLBMockMap) UpsertService(p *datapathTypes.UpsertServiceParams) error {
+	if err := s.enter("UpsertService"); err != nil {
+		return err
+	}
+	if s.MaxServiceEntries > 0 {
+		s.Lock()
+		_, exists := s.ServiceByID[p.ID]
+		full := !exists && len(s.ServiceByID) >= s.MaxServiceEntries
+		s.Unlock()
+		if full {
+			return fmt.Errorf("unable to insert service %d: map is full (max %d entries)",
+				p.ID, s.MaxServiceEntries)
+		}
+	}
+	return s.LBMockMap.UpsertService(p)
+}
+
+func (s *ScaleLBMockMap) UpsertMaglevLookupTable(svcID uint16, backends map[string]*lb.Backend, ipv6 bool) error {
+	if err := s.enter("UpsertMaglevLookupTable"); err != nil {
+		return err
+	}
+	return s.LBMockMap.UpsertMaglevLookupTable(svcID, backends, ipv6)
+}
+
+func (s *ScaleLBMockMap) DeleteService(addr lb.L3n4AddrID, backendCount int, useMaglev bool, natPolicy lb.SVCNatPolicy) error {
+	if err := s.enter("DeleteService"); err != nil {
+		return err
+	}
+	return s.LBMockMap.DeleteService(addr, backendCount, useMaglev, natPolicy)
+}
+
+func (s *ScaleLBMockMap) AddBackend(b *lb.Backend, ipv6 bool) error {
+	if err := s.enter("AddBackend"); err != nil {
+		return err
+	}
+	if s.MaxBackendEntries > 0 {
+		s.Lock()
+		_, exists := s.BackendByID[b.ID]
+		full := !exists && len(s.BackendByID) >= s.MaxBackendEntries
+		s.Unlock()
+		if full {
+			return fmt.Errorf("unable to insert backend %d: map is full (max %d entries)",
+				b.ID, s.MaxBackendEntries)
+		}
+	}
+	return s.LBMockMap.AddBackend(b, ipv6)
+}
+
+func (s *ScaleLBMockMap) UpdateBackendWithState(b *lb.Backend) error {
+	if err := s.enter("UpdateBackendWithState"); err != nil {
+		return err
+	}
+	return s.LBMockMap.UpdateBackendWithState(b)
+}
+
+func (s *ScaleLBMockMap) DeleteBackendByID(id lb.BackendID) error {
+	if err := s.enter("DeleteBackendByID"); err != nil {
+		return err
+	}
+	return s.LBMockMap.DeleteBackendByID(id)
+}
+
+func (s *ScaleLBMockMap) AddAffinityMatch(revNATID uint16, backendID lb.BackendID) error {
+	if err := s.enter("AddAffinityMatch"); err != nil {
+		return err
+	}
+	return s.LBMockMap.AddAffinityMatch(revNATID, backendID)
+}
+
+func (s *ScaleLBMockMap) DeleteAffinityMatch(revNATID uint16, backendID lb.BackendID) error {
+	if err := s.enter("DeleteAffinityMatch"); err != nil {
+		return err
+	}
+	return s.LBMockMap.DeleteAffinityMatch(revNATID, backendID)
+}
+
+func (s *ScaleLBMockMap) UpdateSourceRanges(revNATID uint16, ranges []*cidr.CIDR, ipv6 bool) (uint16, error) {
+	if err := s.enter("UpdateSourceRanges"); err != nil {
+		return 0, err
+	}
+	return s.LBMockMap.UpdateSourceRanges(revNATID, ranges, ipv6)
+}
+
+func (s *ScaleLBMockMap) DeleteSourceRanges(id uint16, ranges []*cidr.CIDR, ipv6 bool) error {
+	if err := s.enter("DeleteSourceRanges"); err != nil {
+		return err
+	}
+	return s.LBMockMap.DeleteSourceRanges(id, ranges, ipv6)
+}
@@ -0,0 +1,278 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// svcloadtest generates synthetic Kubernetes service and endpoint churn
+// against a cluster running the Cilium agent and measures how long the agent
+// takes to reconcile each change into its service table. It is meant to be run
+// from a node with access to both the kube-apiserver (e.g. a kind cluster) and
+// the local agent API socket:
+//
+//	go run ./tools/svcloadtest --services 100 --backends 10 --duration 2m
+//
+// For every created service and every endpoint rotation the tool polls the
+// agent service API until the change is visible and records the propagation
+// latency. At the end it prints latency percentiles, the achieved churn rate
+// and the implied datapath map update rate, which can be compared across
+// agent versions to track control-plane scalability regressions.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	ciliumClient "github.com/cilium/cilium/pkg/client"
+)
+
+var (
+	kubeconfig  = flag.String("kubeconfig", defaultKubeconfig(), "Path to the kubeconfig file")
+	ciliumHost  = flag.String("host", "", "URI of the Cilium agent API (default: local agent socket)")
+	namespace   = flag.String("namespace", "svcloadtest", "Namespace to create the synthetic services in")
+	numServices = flag.Int("services", 50, "Number of services to create")
+	numBackends = flag.Int("backends", 10, "Number of backends per service")
+	churnDelay  = flag.Duration("churn-delay", 100*time.Millisecond, "Delay between endpoint churn operations")
+	duration    = flag.Duration("duration", time.Minute, "Duration of the endpoint churn phase")
+	opTimeout   = flag.Duration("op-timeout", 30*time.Second, "Time to wait for a single change to propagate")
+	keep        = flag.Bool("keep", false, "Do not delete the namespace on exit")
+)
+
+const (
+	servicePort  = int32(8080)
+	pollInterval = 25 * time.Millisecond
+)
+
+func defaultKubeconfig() string {
+	if env := os.Getenv("KUBECONFIG"); env != "" {
+		return env
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".kube", "config")
+}
+
+// backendIPs returns the synthetic backend IPs of the given service for the
+// given churn generation. The IPs are unique across services and generations
+// so that every rotation replaces the full backend set.
+func backendIPs(svcIdx, generation, count int) []string {
+	ips := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		n := (svcIdx*251+generation)*count + i
+		ips = append(ips, fmt.Sprintf("240.%d.%d.%d", n>>16&0xff, n>>8&0xff, n&0xff))
+	}
+	return ips
+}
+
+func svcName(idx int) string {
+	return fmt.Sprintf("svcloadtest-%d", idx)
+}
+
+func endpointSlice(idx, generation int) *discoveryv1.EndpointSlice {
+	ready := true
+	portName := "http"
+	proto := corev1.ProtocolTCP
+	port := servicePort
+	slice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      svcName(idx),
+			Namespace: *namespace,
+			Labels: map[string]string{
+				discoveryv1.LabelServiceName: svcName(idx),
+			},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Ports: []discoveryv1.EndpointPort{
+			{Name: &portName, Protocol: &proto, Port: &port},
+		},
+	}
+	for _, ip := range backendIPs(idx, generation, *numBackends) {
+		slice.Endpoints = append(slice.Endpoints, discoveryv1.Endpoint{
+			Addresses:  []string{ip},
+			Conditions: discoveryv1.EndpointConditions{Ready: &ready},
+		})
+	}
+	return slice
+}
+
+// waitForBackends polls the agent service API until the service with the
+// given frontend exposes exactly the given backend IPs, and returns the time
+// it took for the change to become visible.
+func waitForBackends(cilium *ciliumClient.Client, frontendIP string, want []string) (time.Duration, error) {
+	wantSet := make(map[string]struct{}, len(want))
+	for _, ip := range want {
+		wantSet[ip] = struct{}{}
+	}
+	start := time.Now()
+	for {
+		svcs, err := cilium.GetServices()
+		if err != nil {
+			return 0, err
+		}
+		for _, svc := range svcs {
+			if svc.Spec == nil || svc.Spec.FrontendAddress == nil ||
+				svc.Spec.FrontendAddress.IP != frontendIP ||
+				svc.Spec.FrontendAddress.Port != uint16(servicePort) {
+				continue
+			}
+			matched := 0
+			for _, be := range svc.Spec.BackendAddresses {
+				if be.IP == nil {
+					continue
+				}
+				if _, ok := wantSet[*be.IP]; ok {
+					matched++
+				}
+			}
+			if matched == len(wantSet) && len(svc.Spec.BackendAddresses) == len(wantSet) {
+				return time.Since(start), nil
+			}
+		}
+		if time.Since(start) > *opTimeout {
+			return 0, fmt.Errorf("change to %s not visible in the agent after %s", frontendIP, *opTimeout)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(float64(len(sorted)-1) * p)
+	return sorted[idx]
+}
+
+func report(phase string, latencies []time.Duration, elapsed time.Duration, mapUpdates int) {
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	fmt.Printf("%s: %d operations in %s (%.1f ops/s, ~%.1f map updates/s)\n",
+		phase, len(latencies), elapsed.Round(time.Millisecond),
+		float64(len(latencies))/elapsed.Seconds(),
+		float64(mapUpdates)/elapsed.Seconds())
+	fmt.Printf("  propagation latency: p50=%s p90=%s p99=%s max=%s\n",
+		percentile(latencies, 0.50).Round(time.Millisecond),
+		percentile(latencies, 0.90).Round(time.Millisecond),
+		percentile(latencies, 0.99).Round(time.Millisecond),
+		percentile(latencies, 1.0).Round(time.Millisecond))
+}
+
+func run(ctx context.Context, k8s *kubernetes.Clientset, cilium *ciliumClient.Client) error {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: *namespace}}
+	if _, err := k8s.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("unable to create namespace %s: %w", *namespace, err)
+	}
+	if !*keep {
+		defer func() {
+			err := k8s.CoreV1().Namespaces().Delete(context.Background(), *namespace, metav1.DeleteOptions{})
+			if err != nil {
+				log.Printf("unable to delete namespace %s: %s", *namespace, err)
+			}
+		}()
+	}
+
+	// Creation phase: create all services with their initial backend set and
+	// wait for each one to be reconciled by the agent. A service creation
+	// results in one service map entry per frontend plus one backend map
+	// entry per backend.
+	clusterIPs := make([]string, *numServices)
+	createLatencies := make([]time.Duration, 0, *numServices)
+	createStart := time.Now()
+	for i := 0; i < *numServices; i++ {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      svcName(i),
+				Namespace: *namespace,
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{
+					{Name: "http", Port: servicePort, Protocol: corev1.ProtocolTCP},
+				},
+			},
+		}
+		created, err := k8s.CoreV1().Services(*namespace).Create(ctx, svc, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("unable to create service %s: %w", svcName(i), err)
+		}
+		clusterIPs[i] = created.Spec.ClusterIP
+		if _, err := k8s.DiscoveryV1().EndpointSlices(*namespace).Create(ctx, endpointSlice(i, 0), metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("unable to create endpoint slice %s: %w", svcName(i), err)
+		}
+		lat, err := waitForBackends(cilium, clusterIPs[i], backendIPs(i, 0, *numBackends))
+		if err != nil {
+			return err
+		}
+		createLatencies = append(createLatencies, lat)
+	}
+	report("creation", createLatencies, time.Since(createStart),
+		*numServices*(1+*numBackends))
+
+	// Churn phase: rotate the full backend set of a random service and wait
+	// for the rotation to be reconciled. Each rotation removes and adds
+	// numBackends backend map entries and rewrites the service's backend
+	// slots.
+	generations := make([]int, *numServices)
+	churnLatencies := []time.Duration{}
+	churnStart := time.Now()
+	for time.Since(churnStart) < *duration {
+		i := rand.Intn(*numServices)
+		generations[i]++
+		slice := endpointSlice(i, generations[i])
+		old, err := k8s.DiscoveryV1().EndpointSlices(*namespace).Get(ctx, slice.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("unable to get endpoint slice %s: %w", slice.Name, err)
+		}
+		slice.ResourceVersion = old.ResourceVersion
+		if _, err := k8s.DiscoveryV1().EndpointSlices(*namespace).Update(ctx, slice, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("unable to update endpoint slice %s: %w", slice.Name, err)
+		}
+		lat, err := waitForBackends(cilium, clusterIPs[i], backendIPs(i, generations[i], *numBackends))
+		if err != nil {
+			return err
+		}
+		churnLatencies = append(churnLatencies, lat)
+		time.Sleep(*churnDelay)
+	}
+	report("endpoint churn", churnLatencies, time.Since(churnStart),
+		len(churnLatencies)*2**numBackends)
+
+	return nil
+}
+
+func main() {
+	flag.Parse()
+
+	config, err := clientcmd.BuildConfigFromFlags("", *kubeconfig)
+	if err != nil {
+		log.Fatalf("unable to load kubeconfig: %s", err)
+	}
+	k8s, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		log.Fatalf("unable to create Kubernetes client: %s", err)
+	}
+
+	var cilium *ciliumClient.Client
+	if *ciliumHost != "" {
+		cilium, err = ciliumClient.NewClient(*ciliumHost)
+	} else {
+		cilium, err = ciliumClient.NewDefaultClient()
+	}
+	if err != nil {
+		log.Fatalf("unable to create Cilium API client: %s", err)
+	}
+
+	if err := run(context.Background(), k8s, cilium); err != nil {
+		log.Fatal(err)
+	}
+}